@@ -3,6 +3,7 @@ package char
 import (
 	"errors"
 	"fmt"
+	"unicode/utf16"
 )
 
 const END_OF_STRING uint16 = 0xFFFF
@@ -11,6 +12,12 @@ const NULL_CHAR uint16 = 0x0
 var chars [496]string = [496]string{"␀", "　", "ぁ", "あ", "ぃ", "い", "ぅ", "う", "ぇ", "え", "ぉ", "お", "か", "が", "き", "ぎ", "く", "ぐ", "け", "げ", "こ", "ご", "さ", "ざ", "し", "じ", "す", "ず", "せ", "ぜ", "そ", "ぞ", "た", "だ", "ち", "ぢ", "っ", "つ", "づ", "て", "で", "と", "ど", "な", "に", "ぬ", "ね", "の", "は", "ば", "ぱ", "ひ", "び", "ぴ", "ふ", "ぶ", "ぷ", "へ", "べ", "ぺ", "ほ", "ぼ", "ぽ", "ま", "み", "む", "め", "も", "ゃ", "や", "ゅ", "ゆ", "ょ", "よ", "ら", "り", "る", "れ", "ろ", "わ", "を", "ん", "ァ", "ア", "ィ", "イ", "ゥ", "ウ", "ェ", "エ", "ォ", "オ", "カ", "ガ", "キ", "ギ", "ク", "グ", "ケ", "ゲ", "コ", "ゴ", "サ", "ザ", "シ", "ジ", "ス", "ズ", "セ", "ゼ", "ソ", "ゾ", "タ", "ダ", "チ", "ヂ", "ッ", "ツ", "ヅ", "テ", "デ", "ト", "ド", "ナ", "ニ", "ヌ", "ネ", "ノ", "ハ", "バ", "パ", "ヒ", "ビ", "ピ", "フ", "ブ", "プ", "ヘ", "ベ", "ペ", "ホ", "ボ", "ポ", "マ", "ミ", "ム", "メ", "モ", "ャ", "ヤ", "ュ", "ユ", "ョ", "ヨ", "ラ", "リ", "ル", "レ", "ロ", "ワ", "ヲ", "ン", "０", "１", "２", "３", "４", "５", "６", "７", "８", "９", "Ａ", "Ｂ", "Ｃ", "Ｄ", "Ｅ", "Ｆ", "Ｇ", "Ｈ", "Ｉ", "Ｊ", "Ｋ", "Ｌ", "Ｍ", "Ｎ", "Ｏ", "Ｐ", "Ｑ", "Ｒ", "Ｓ", "Ｔ", "Ｕ", "Ｖ", "Ｗ", "Ｘ", "Ｙ", "Ｚ", "ａ", "ｂ", "ｃ", "ｄ", "ｅ", "ｆ", "ｇ", "ｈ", "ｉ", "ｊ", "ｋ", "ｌ", "ｍ", "ｎ", "ｏ", "ｐ", "ｑ", "ｒ", "ｓ", "ｔ", "ｕ", "ｖ", "ｗ", "ｘ", "ｙ", "ｚ", "", "！", "？", "、", "。", "…", "・", "／", "「", "」", "『", "』", "（", "）", "♂", "♀", "＋", "ー", "×", "÷", "＝", "～", "：", "；", "．", "，", "♠", "♣", "♥", "♦", "★", "◎", "○", "□", "△", "◇", "＠", "♪", "％", "☀", "☁", "☂", "☃", "", "", "", "", "", "", "", "円", "", "", "", "", "", "", "", "", "←", "↑", "↓", "→", "►", "＆", "0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z", "a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z", "À", "Á", "Â", "Ã", "Ä", "Å", "Æ", "Ç", "È", "É", "Ê", "Ë", "Ì", "Í", "Î", "Ï", "Ð", "Ñ", "Ò", "Ó", "Ô", "Õ", "Ö", "×", "Ø", "Ù", "Ú", "Û", "Ü", "Ý", "Þ", "ß", "à", "á", "â", "ã", "ä", "å", "æ", "ç", "è", "é", "ê", "ë", "ì", "í", "î", "ï", "ð", "ñ", "ò", "ó", "ô", "õ", "ö", "÷", "ø", "ù", "ú", "û", "ü", "ý", "þ", "ÿ", "Œ", "œ", "Ş", "ş", "ª", "º", "er", "re", "ʳ", "", "¡", "¿", "!", "?", ",", ".", "…", "･", "/", "‘", "’", "“", "”", "„", "«", "»", "(", ")", "♂", "♀", "+", "-", "*", "#", "=", "&", "~", ":", ";", "♠", "♣", "♥", "♦", "★", "◎", "○", "□", "△", "◇", "@", "♪", "%", "☀", "☁", "☂", "☃", "", "", "", "", "", "", "", " ", "e", "PK", "MN", " ", " ", " ", " ", " ", " ", "°", "_", "＿", "․", "‥", "", "", ""}
 var charsMap map[string]uint16 = map[string]uint16{}
 
+// gen 5 games store text as UTF-16, except for the gender symbols which use private codepoints
+var gen5Symbols map[uint16]string = map[uint16]string{
+	0x246D: "♂",
+	0x246E: "♀",
+}
+
 func init() {
 	for i, c := range chars {
 		if _, ok := charsMap[c]; !ok {
@@ -36,3 +43,34 @@ func Index(char string) (uint16, error) {
 
 	return 0, fmt.Errorf("nonexistent character '%s'", char)
 }
+
+func CharGen5(code uint16) (string, error) {
+	if code == END_OF_STRING || code == NULL_CHAR {
+		return "", errors.New("invalid index")
+	}
+
+	if symbol, ok := gen5Symbols[code]; ok {
+		return symbol, nil
+	}
+
+	if utf16.IsSurrogate(rune(code)) {
+		return "", fmt.Errorf("unsupported character code 0x%x", code)
+	}
+
+	return string(rune(code)), nil
+}
+
+func IndexGen5(char string) (uint16, error) {
+	for code, symbol := range gen5Symbols {
+		if symbol == char {
+			return code, nil
+		}
+	}
+
+	runes := []rune(char)
+	if len(runes) != 1 || runes[0] > 0xFFFF || utf16.IsSurrogate(runes[0]) {
+		return 0, fmt.Errorf("nonexistent character '%s'", char)
+	}
+
+	return uint16(runes[0]), nil
+}
@@ -37,3 +37,31 @@ func TestCharValidChars(t *testing.T) {
 		t.Fatal("Incorrect character received")
 	}
 }
+
+func TestCharGen5(t *testing.T) {
+	char, err := CharGen5(0x0044)
+	if err != nil {
+		t.Fatal("Unexpected error received")
+	}
+
+	if char != "D" {
+		t.Fatal("Incorrect character received")
+	}
+
+	if _, err := CharGen5(END_OF_STRING); err == nil {
+		t.Fatalf("Null-terminating character not handled properly\n")
+	}
+}
+
+func TestIndexGen5Symbols(t *testing.T) {
+	for code, symbol := range gen5Symbols {
+		index, err := IndexGen5(symbol)
+		if err != nil {
+			t.Fatal("Unexpected error received")
+		}
+
+		if index != code {
+			t.Fatalf("expected 0x%x, got 0x%x\n", code, index)
+		}
+	}
+}
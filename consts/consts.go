@@ -1,25 +1,101 @@
 package consts
 
+const SAVEFILE_SIZE = 0x80000
 const BLOCK_SIZE_BYTES = 32
 const PARTY_POKEMON_SIZE = 236
+const PARTY_POKEMON_SIZE_GEN5 = 220
 const PERSONALITY_OFFSET = 0xA0
 const PERSONALITY_OFFSET_HGSS = 0x98
 
 const (
 	BLOCK_A_ITEM = 0x2
+	BLOCK_A_OT_ID = 0x4
+	BLOCK_A_OT_SECRET_ID = 0x6
+	BLOCK_A_EXPERIENCE = 0x8
+	BLOCK_A_FRIENDSHIP = 0xC
 	BLOCK_A_ABILITY = 0xD
+	BLOCK_A_MARKINGS = 0xE
+	BLOCK_A_LANGUAGE = 0xF
 	BLOCK_A_EV = 0x10
+	BLOCK_A_CONTEST = 0x16
+	BLOCK_A_RIBBONS_SINNOH = 0x1C
 )
 
 const (
+	BLOCK_B_MOVES = 0x0
+	BLOCK_B_PP = 0x8
+	BLOCK_B_PP_UPS = 0xC
 	BLOCK_B_IV = 0x10
+	BLOCK_B_RIBBONS_HOENN = 0x14
+	BLOCK_B_FLAGS = 0x18
+	BLOCK_B_NATURE_GEN5 = 0x19
+	BLOCK_B_EGG_LOCATION_PT = 0x1C
+	BLOCK_B_MET_LOCATION_PT = 0x1E
 )
 
 const (
 	BLOCK_C_NICKNAME = 0x0
+	BLOCK_C_ORIGIN_GAME = 0x17
+	BLOCK_C_RIBBONS_SINNOH_2 = 0x18
 )
 
+const (
+	BLOCK_D_OT_NAME = 0x0
+	BLOCK_D_EGG_DATE = 0x10
+	BLOCK_D_MET_DATE = 0x13
+	BLOCK_D_EGG_LOCATION = 0x16
+	BLOCK_D_MET_LOCATION = 0x18
+	BLOCK_D_POKERUS = 0x1A
+	BLOCK_D_BALL = 0x1B
+	BLOCK_D_MET_LEVEL = 0x1C
+	BLOCK_D_BALL_HGSS = 0x1E
+)
+
+// party pokemon only
+const BATTLE_STATS_OFFSET = 0x88
+
+// relative to BATTLE_STATS_OFFSET
 const (
 	BATTLE_STATS_LEVEL = 0x4
+	BATTLE_STATS_CURRENT_HP = 0x6
 	BATTLE_STATS_STAT = 0x8
-)
\ No newline at end of file
+)
+
+// relative to the start of the trainer section
+const (
+	TRAINER_NAME = 0x0
+	TRAINER_NAME_SIZE = 16
+	TRAINER_TID = 0x10
+	TRAINER_SID = 0x12
+	TRAINER_MONEY = 0x14
+	TRAINER_GENDER = 0x18
+	TRAINER_BADGES = 0x1A
+	TRAINER_BADGES_KANTO = 0x1F
+	TRAINER_PLAY_HOURS = 0x22
+	TRAINER_PLAY_MINUTES = 0x24
+	TRAINER_PLAY_SECONDS = 0x25
+)
+
+const MAX_MONEY = 999999
+const MAX_PLAY_HOURS = 999
+
+const BOX_POKEMON_SIZE = 136
+const BOX_COUNT = 18
+const BOX_SLOTS = 30
+
+// relative to the start of the box metadata section
+const (
+	BOX_NAME_SIZE = 40
+	BOX_NAME_MAX_LENGTH = 8
+	BOX_WALLPAPERS = BOX_COUNT * BOX_NAME_SIZE
+	BOX_WALLPAPER_FLAGS = BOX_WALLPAPERS + BOX_COUNT
+)
+
+// wallpapers past the regular ones need to be unlocked first, one flag bit each
+const REGULAR_WALLPAPERS = 16
+const SPECIAL_WALLPAPERS = 8
+
+// per-slot quantity caps. Key items can't be stacked
+const MAX_ITEM_QUANTITY = 999
+const MAX_TM_QUANTITY = 99
+const MAX_KEY_ITEM_QUANTITY = 1
@@ -6,4 +6,10 @@ const (
 	DP GameVer = iota
 	PLAT
 	HGSS
-)
\ No newline at end of file
+	BW
+	B2W2
+)
+
+func (v GameVer) IsGen5() bool {
+	return v == BW || v == B2W2
+}
@@ -0,0 +1,34 @@
+package pocket
+
+type Pocket int
+
+// in the order the pockets are shown in-game
+const (
+	ITEMS Pocket = iota
+	MEDICINE
+	POKE_BALLS
+	TMS_HMS
+	BERRIES
+	MAIL
+	BATTLE_ITEMS
+	KEY_ITEMS
+)
+
+var names = [...]string{
+	"Items",
+	"Medicine",
+	"Poké Balls",
+	"TMs & HMs",
+	"Berries",
+	"Mail",
+	"Battle Items",
+	"Key Items",
+}
+
+func (p Pocket) String() string {
+	if p < 0 || int(p) >= len(names) {
+		return "Unknown"
+	}
+
+	return names[p]
+}
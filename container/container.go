@@ -0,0 +1,79 @@
+package container
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+)
+
+type Format int
+
+const (
+	RAW           Format = iota
+	DESMUME              // .dsv: raw savefile followed by a 122-byte footer
+	PADDED               // 1MB dump: raw savefile followed by padding, or a mirror of itself
+	ACTION_REPLAY        // .duc: 500-byte header followed by the raw savefile
+	GAMESHARK            // 164-byte header followed by the raw savefile
+)
+
+const DESMUME_FOOTER_SIZE = 122
+const ACTION_REPLAY_HEADER_SIZE = 500
+const GAMESHARK_HEADER_SIZE = 0xA4
+const PADDED_SIZE = 0x100000
+
+var desmumeFooterStart = []byte("|<--Snip above here to create a raw sav by excluding this DeSmuME savedata footer:")
+var desmumeFooterEnd = []byte("|-DESMUME SAVE-|")
+var actionReplayMagic = []byte("ARDS000000000001")
+
+// Describes how a raw savefile was packaged, so that it can be packaged the same way after edits
+type Container struct {
+	Format Format
+	Header []byte
+	Footer []byte
+	Mirror bool // the padding was a copy of the savefile itself
+}
+
+// Detects the container format of the given file, and returns the raw 512KB savefile inside it.
+// The returned savefile is a subslice of the given file
+func Unwrap(file []byte) ([]byte, Container, error) {
+	size := len(file)
+
+	switch {
+	case size == consts.SAVEFILE_SIZE:
+		return file, Container{Format: RAW}, nil
+
+	case size == consts.SAVEFILE_SIZE+DESMUME_FOOTER_SIZE && isDesmumeFooter(file[consts.SAVEFILE_SIZE:]):
+		return file[:consts.SAVEFILE_SIZE], Container{DESMUME, nil, file[consts.SAVEFILE_SIZE:], false}, nil
+
+	case size == PADDED_SIZE:
+		raw, padding := file[:consts.SAVEFILE_SIZE], file[consts.SAVEFILE_SIZE:]
+		return raw, Container{PADDED, nil, padding, bytes.Equal(raw, padding)}, nil
+
+	case size == consts.SAVEFILE_SIZE+ACTION_REPLAY_HEADER_SIZE && bytes.HasPrefix(file, actionReplayMagic):
+		return file[ACTION_REPLAY_HEADER_SIZE:], Container{ACTION_REPLAY, file[:ACTION_REPLAY_HEADER_SIZE], nil, false}, nil
+
+	// GameShark headers don't carry a magic value, so they can only be recognized by size
+	case size == consts.SAVEFILE_SIZE+GAMESHARK_HEADER_SIZE:
+		return file[GAMESHARK_HEADER_SIZE:], Container{GAMESHARK, file[:GAMESHARK_HEADER_SIZE], nil, false}, nil
+	}
+
+	return []byte{}, Container{}, fmt.Errorf("unrecognized savefile container (%d bytes)", size)
+}
+
+// Packages the raw savefile into a new buffer, in the same format it was unwrapped from
+func (c Container) Wrap(raw []byte) []byte {
+	res := make([]byte, 0, len(c.Header)+len(raw)+len(c.Footer))
+	res = append(res, c.Header...)
+	res = append(res, raw...)
+
+	if c.Mirror {
+		return append(res, raw...)
+	}
+
+	return append(res, c.Footer...)
+}
+
+func isDesmumeFooter(footer []byte) bool {
+	return bytes.HasPrefix(footer, desmumeFooterStart) && bytes.HasSuffix(footer, desmumeFooterEnd)
+}
@@ -0,0 +1,81 @@
+package container
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+)
+
+func mockSavefile() []byte {
+	raw := make([]byte, consts.SAVEFILE_SIZE)
+	for i := range raw {
+		raw[i] = byte(i * 7)
+	}
+
+	return raw
+}
+
+func desmumeFooter() []byte {
+	footer := append([]byte{}, desmumeFooterStart...)
+	footer = append(footer, make([]byte, 24)...)
+	return append(footer, desmumeFooterEnd...)
+}
+
+func TestUnwrap(t *testing.T) {
+	raw := mockSavefile()
+	padding := bytes.Repeat([]byte{0xFF}, consts.SAVEFILE_SIZE)
+	actionReplayHeader := append(append([]byte{}, actionReplayMagic...), make([]byte, ACTION_REPLAY_HEADER_SIZE-len(actionReplayMagic))...)
+
+	cases := []struct {
+		file   []byte
+		format Format
+	}{
+		{raw, RAW},
+		{append(append([]byte{}, raw...), desmumeFooter()...), DESMUME},
+		{append(append([]byte{}, raw...), padding...), PADDED},
+		{append(append([]byte{}, raw...), raw...), PADDED},
+		{append(actionReplayHeader, raw...), ACTION_REPLAY},
+		{append(make([]byte, GAMESHARK_HEADER_SIZE), raw...), GAMESHARK},
+	}
+
+	for _, c := range cases {
+		unwrapped, packaging, err := Unwrap(c.file)
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		if packaging.Format != c.format {
+			t.Fatalf("expected %d but got %d\n", c.format, packaging.Format)
+		}
+
+		if !bytes.Equal(unwrapped, raw) {
+			t.Fatalf("format %d: unwrapped savefile doesn't match the raw savefile\n", c.format)
+		}
+
+		if !bytes.Equal(packaging.Wrap(unwrapped), c.file) {
+			t.Fatalf("format %d: rewrapped file doesn't match the original file\n", c.format)
+		}
+	}
+}
+
+func TestWrapMirror(t *testing.T) {
+	raw := mockSavefile()
+	unwrapped, packaging, err := Unwrap(append(append([]byte{}, raw...), raw...))
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	unwrapped[0] ^= 0xFF
+	wrapped := packaging.Wrap(unwrapped)
+
+	if wrapped[consts.SAVEFILE_SIZE] != unwrapped[0] {
+		t.Fatal("expected the mirror to follow edits to the savefile")
+	}
+}
+
+func TestUnwrapUnknownSize(t *testing.T) {
+	if _, _, err := Unwrap(make([]byte, consts.SAVEFILE_SIZE+1)); err == nil {
+		t.Fatal("expected file of unknown size to be rejected")
+	}
+}
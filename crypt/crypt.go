@@ -2,8 +2,9 @@ package crypt
 
 import (
 	"encoding/binary"
-	"log"
+	"fmt"
 
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
 	"github.com/dingdongg/pkmn-rom-parser/v7/prng"
 )
 
@@ -18,50 +19,24 @@ func CRC16_CCITT(data []byte) uint16 {
 	return uint16(sum)
 }
 
-// Encrypts the given pokemon. Checksum will be updated as part of encryption
-func EncryptPokemon(plaintext []byte) []byte {
-	personality := binary.LittleEndian.Uint32(plaintext[0:4])
-	// do i use the previous checksum? or the new plaintextSum calculated below?
-	// UPDATE: I think im supposed to use the new checksum
-	// checksum := binary.LittleEndian.Uint16(plaintext[6 : 8])
-
-	buffer := make([]byte, 8)
-	copy(buffer, plaintext[:8])
-
-	plaintextSum := uint16(0)
-
-	for i := 0x8; i < 0x87; i += 2 {
-		word := binary.LittleEndian.Uint16(plaintext[i : i+2])
-		plaintextSum += word
-	}
-
-	rand := prng.Init(plaintextSum, personality)
-
-	for i := 0x8; i < 0x87; i += 2 {
-		word := binary.LittleEndian.Uint16(plaintext[i : i+2])
-		encrypted := word ^ rand.Next()
-		little := byte(encrypted & 0xFF)
-		big := byte((encrypted >> 8) & 0xFF)
-		buffer = append(buffer, little, big)
+// Encrypts the first size bytes of the given decrypted pokemon. size is 136 for box pokemon
+// (and .ek4 files), 236 for gen 4 party pokemon or 220 for gen 5 ones, which have shorter
+// battle stats. Checksum will be updated as part of encryption
+func Encrypt(plaintext []byte, size int) ([]byte, error) {
+	if err := checkSize(plaintext, size); err != nil {
+		return []byte{}, err
 	}
 
-	binary.LittleEndian.PutUint16(buffer[6:8], plaintextSum)
-	return append(buffer, EncryptBattleStats(plaintext[0x88:], personality)...)
+	return encrypt(plaintext, size), nil
 }
 
-func EncryptBattleStats(plaintext []byte, personality uint32) []byte {
-	bsprng := prng.InitBattleStatPRNG(personality)
-	var buffer []byte
-
-	for i := 0; i < 0x64; i += 2 {
-		decrypted := bsprng.Next() ^ binary.LittleEndian.Uint16(plaintext[i:i+2])
-		buffer = append(buffer, byte(decrypted&0xFF), byte((decrypted>>8)&0xFF))
+// Decrypts the first size bytes of the given pokemon, with the same sizes as Encrypt.
+// Fails if the decrypted blocks don't match the checksum
+func Decrypt(ciphertext []byte, size int) ([]byte, error) {
+	if err := checkSize(ciphertext, size); err != nil {
+		return []byte{}, err
 	}
 
-	return buffer
-}
-
-func DecryptPokemon(ciphertext []byte) []byte {
 	personality := binary.LittleEndian.Uint32(ciphertext[0:4])
 	checksum := binary.LittleEndian.Uint16(ciphertext[6:8])
 
@@ -81,21 +56,88 @@ func DecryptPokemon(ciphertext []byte) []byte {
 	}
 
 	if plaintextSum != checksum {
-		log.Fatalf("Checksum invalid. expected 0x%x, got 0x%x\n", checksum, plaintextSum)
+		return []byte{}, fmt.Errorf("checksum invalid. expected 0x%x, got 0x%x", checksum, plaintextSum)
+	}
+
+	return append(buffer, cryptBattleStats(ciphertext[consts.BOX_POKEMON_SIZE:size], personality)...), nil
+}
+
+// Encrypts the given gen 4 party pokemon. Checksum will be updated as part of encryption
+func EncryptPokemon(plaintext []byte) []byte {
+	return encrypt(plaintext, consts.PARTY_POKEMON_SIZE)
+}
+
+// Decrypts the given gen 4 party pokemon
+func DecryptPokemon(ciphertext []byte) ([]byte, error) {
+	return Decrypt(ciphertext, consts.PARTY_POKEMON_SIZE)
+}
+
+// Sums the words of blocks A to D of a decrypted pokemon, in any block order. Pokemon
+// store this checksum at 0x06
+func Checksum(plaintext []byte) uint16 {
+	sum := uint16(0)
+
+	for i := 0x8; i < 0x87; i += 2 {
+		sum += binary.LittleEndian.Uint16(plaintext[i : i+2])
+	}
+
+	return sum
+}
+
+func checkSize(buf []byte, size int) error {
+	if size != consts.BOX_POKEMON_SIZE && size != consts.PARTY_POKEMON_SIZE && size != consts.PARTY_POKEMON_SIZE_GEN5 {
+		return fmt.Errorf("invalid pokemon size %d", size)
+	}
+
+	if len(buf) < size {
+		return fmt.Errorf("expected at least %d bytes of pokemon data, got %d", size, len(buf))
+	}
+
+	return nil
+}
+
+func encrypt(plaintext []byte, size int) []byte {
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+	// do i use the previous checksum? or the new plaintextSum calculated below?
+	// UPDATE: I think im supposed to use the new checksum
+	// checksum := binary.LittleEndian.Uint16(plaintext[6 : 8])
+
+	buffer := make([]byte, 8)
+	copy(buffer, plaintext[:8])
+
+	plaintextSum := Checksum(plaintext)
+	rand := prng.Init(plaintextSum, personality)
+
+	for i := 0x8; i < 0x87; i += 2 {
+		word := binary.LittleEndian.Uint16(plaintext[i : i+2])
+		encrypted := word ^ rand.Next()
+		little := byte(encrypted & 0xFF)
+		big := byte((encrypted >> 8) & 0xFF)
+		buffer = append(buffer, little, big)
 	}
 
-	return append(buffer, DecryptBattleStats(ciphertext[0x88:], personality)...)
+	binary.LittleEndian.PutUint16(buffer[6:8], plaintextSum)
+	return append(buffer, cryptBattleStats(plaintext[consts.BOX_POKEMON_SIZE:size], personality)...)
+}
+
+func EncryptBattleStats(plaintext []byte, personality uint32) []byte {
+	return cryptBattleStats(plaintext[:0x64], personality)
 }
 
 // first block of ciphertext points to offset 0x88 in a whole party pokemon block
 func DecryptBattleStats(ciphertext []byte, personality uint32) []byte {
+	return cryptBattleStats(ciphertext[:0x64], personality)
+}
+
+// battle stats are XORed with their own PRNG, so encryption and decryption are the same
+func cryptBattleStats(buf []byte, personality uint32) []byte {
 	bsprng := prng.InitBattleStatPRNG(personality)
-	var plaintext []byte
+	var res []byte
 
-	for i := 0; i < 0x64; i += 2 {
-		decrypted := bsprng.Next() ^ binary.LittleEndian.Uint16(ciphertext[i:i+2])
-		plaintext = append(plaintext, byte(decrypted&0xFF), byte((decrypted>>8)&0xFF))
+	for i := 0; i+1 < len(buf); i += 2 {
+		crypted := bsprng.Next() ^ binary.LittleEndian.Uint16(buf[i:i+2])
+		res = append(res, byte(crypted&0xFF), byte((crypted>>8)&0xFF))
 	}
 
-	return plaintext
+	return res
 }
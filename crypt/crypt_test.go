@@ -0,0 +1,66 @@
+package crypt
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func readMockPokemon(t *testing.T) []byte {
+	ciphertext, err := os.ReadFile("../rom_reader/mocks/mock_pokemon_data")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	return ciphertext
+}
+
+func TestDecryptSizes(t *testing.T) {
+	ciphertext := readMockPokemon(t)
+
+	party, err := Decrypt(ciphertext, 236)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	for _, size := range []int{136, 220, 236} {
+		plaintext, err := Decrypt(ciphertext, size)
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		if !cmp.Equal(plaintext, party[:size]) {
+			t.Fatalf("expected the %d byte form to be a prefix of the party form\n", size)
+		}
+
+		encrypted, err := Encrypt(plaintext, size)
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		if !cmp.Equal(encrypted, ciphertext[:size]) {
+			t.Fatalf("expected encryption to undo decryption for %d bytes\n", size)
+		}
+	}
+}
+
+func TestDecryptValidation(t *testing.T) {
+	ciphertext := readMockPokemon(t)
+
+	if _, err := Decrypt(ciphertext, 200); err == nil {
+		t.Fatal("expected size 200 to be rejected")
+	}
+
+	if _, err := Decrypt(ciphertext[:136], 236); err == nil {
+		t.Fatal("expected a buffer shorter than the size to be rejected")
+	}
+
+	corrupted := make([]byte, len(ciphertext))
+	copy(corrupted, ciphertext)
+	corrupted[0x10] ^= 0xFF
+
+	if _, err := Decrypt(corrupted, 136); err == nil {
+		t.Fatal("expected a checksum mismatch to be rejected")
+	}
+}
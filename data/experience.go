@@ -0,0 +1,88 @@
+package data
+
+import "fmt"
+
+const MAX_LEVEL = 100
+
+// minimum EXP needed to reach each level, indexed by level. Index 0 is unused
+var experienceTables = map[string][MAX_LEVEL + 1]uint{
+	ERRATIC:     buildExperienceTable(erratic),
+	FAST:        buildExperienceTable(func(n int) int { return 4 * n * n * n / 5 }),
+	MEDIUM_FAST: buildExperienceTable(func(n int) int { return n * n * n }),
+	MEDIUM_SLOW: buildExperienceTable(func(n int) int { return 6*n*n*n/5 - 15*n*n + 100*n - 140 }),
+	SLOW:        buildExperienceTable(func(n int) int { return 5 * n * n * n / 4 }),
+	FLUCTUATING: buildExperienceTable(fluctuating),
+}
+
+// every pokemon starts at level 1 with 0 EXP, even when the formula says otherwise
+func buildExperienceTable(formula func(n int) int) [MAX_LEVEL + 1]uint {
+	var table [MAX_LEVEL + 1]uint
+
+	for level := 2; level <= MAX_LEVEL; level++ {
+		table[level] = uint(max(formula(level), 0))
+	}
+
+	return table
+}
+
+func erratic(n int) int {
+	cube := n * n * n
+
+	switch {
+	case n < 50:
+		return cube * (100 - n) / 50
+	case n < 68:
+		return cube * (150 - n) / 100
+	case n < 98:
+		return cube * ((1911 - 10*n) / 3) / 500
+	default:
+		return cube * (160 - n) / 100
+	}
+}
+
+func fluctuating(n int) int {
+	cube := n * n * n
+
+	switch {
+	case n < 15:
+		return cube * ((n+1)/3 + 24) / 50
+	case n < 36:
+		return cube * (n + 14) / 50
+	default:
+		return cube * (n/2 + 32) / 50
+	}
+}
+
+// minimum EXP a pokemon of the given growth rate needs to be at the given level
+func ExperienceForLevel(growthRate string, level uint) (uint, error) {
+	table, ok := experienceTables[growthRate]
+	if !ok {
+		return 0, fmt.Errorf("invalid growth rate '%s'", growthRate)
+	}
+
+	if level < 1 || level > MAX_LEVEL {
+		return 0, fmt.Errorf("level must be between 1 and %d, got %d", MAX_LEVEL, level)
+	}
+
+	return table[level], nil
+}
+
+// level a pokemon of the given growth rate is at with the given EXP.
+// EXP past the level 100 threshold is rejected, since the games cap it there
+func LevelForExperience(growthRate string, exp uint) (uint, error) {
+	table, ok := experienceTables[growthRate]
+	if !ok {
+		return 0, fmt.Errorf("invalid growth rate '%s'", growthRate)
+	}
+
+	if exp > table[MAX_LEVEL] {
+		return 0, fmt.Errorf("EXP must be <= %d for the %s growth rate, got %d", table[MAX_LEVEL], growthRate, exp)
+	}
+
+	level := uint(1)
+	for level < MAX_LEVEL && table[level+1] <= exp {
+		level++
+	}
+
+	return level, nil
+}
@@ -2,6 +2,8 @@ package data
 
 import (
 	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
 )
 
 type itemInfo struct {
@@ -9,7 +11,7 @@ type itemInfo struct {
 	Exclusivity string
 }
 
-var itemsTable [538]itemInfo = [538]itemInfo{
+var itemsTable [537]itemInfo = [537]itemInfo{
 	{"None", "BOTH"},
 	{"Master Ball", "BOTH"},
 	{"Ultra Ball", "BOTH"},
@@ -439,7 +441,6 @@ var itemsTable [538]itemInfo = [538]itemInfo{
 	{"HM07", "BOTH"},
 	{"HM08", "BOTH"},
 	{"Explorer Kit", "PT"},
-	{"???", "HGSS"},
 	{"Loot Sack", "BOTH"},
 	{"Rule Book", "BOTH"},
 	{"Poké Radar", "BOTH"},
@@ -574,4 +575,30 @@ func GenerateItemMap() map[string]mapValue {
 	}
 	
 	return m
-}
\ No newline at end of file
+}
+
+func GetItemPocket(index uint16) (pocket.Pocket, error) {
+	switch {
+	case index == 0 || index >= uint16(len(itemsTable)):
+		return 0, fmt.Errorf("invalid index: %d", index)
+	case index <= 16, index >= 492 && index <= 500:
+		return pocket.POKE_BALLS, nil
+	case index <= 54, index == 504:
+		return pocket.MEDICINE, nil
+	case index <= 64:
+		return pocket.BATTLE_ITEMS, nil
+	case index <= 112, index == 135, index == 136, index >= 213 && index <= 327:
+		return pocket.ITEMS, nil
+	case index >= 137 && index <= 148:
+		return pocket.MAIL, nil
+	case index >= 149 && index <= 212:
+		return pocket.BERRIES, nil
+	case index >= 328 && index <= 427:
+		return pocket.TMS_HMS, nil
+	case index >= 428 && index <= 484, index >= 501:
+		return pocket.KEY_ITEMS, nil
+	}
+
+	// unused IDs and apricorns, which are kept in the Apricorn Box instead
+	return 0, fmt.Errorf("%s can't be stored in the bag", itemsTable[index].Name)
+}
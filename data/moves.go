@@ -0,0 +1,521 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Accuracy is 0 for moves that never miss (or don't check accuracy)
+type MoveInfo struct {
+	Name     string
+	Type     string
+	Category string
+	Power    uint
+	Accuracy uint
+	PP       uint
+}
+
+// gen 4 values, which some later generations changed
+var moveTable [468]MoveInfo = [468]MoveInfo{
+	{"", "", "", 0, 0, 0}, // placeholder for empty move slots
+	{"Pound", "Normal", "Physical", 40, 100, 35},
+	{"Karate Chop", "Fighting", "Physical", 50, 100, 25},
+	{"Double Slap", "Normal", "Physical", 15, 85, 10},
+	{"Comet Punch", "Normal", "Physical", 18, 85, 15},
+	{"Mega Punch", "Normal", "Physical", 80, 85, 20},
+	{"Pay Day", "Normal", "Physical", 40, 100, 20},
+	{"Fire Punch", "Fire", "Physical", 75, 100, 15},
+	{"Ice Punch", "Ice", "Physical", 75, 100, 15},
+	{"Thunder Punch", "Electric", "Physical", 75, 100, 15},
+	{"Scratch", "Normal", "Physical", 40, 100, 35},
+	{"Vise Grip", "Normal", "Physical", 55, 100, 30},
+	{"Guillotine", "Normal", "Physical", 0, 30, 5},
+	{"Razor Wind", "Normal", "Special", 80, 100, 10},
+	{"Swords Dance", "Normal", "Status", 0, 0, 30},
+	{"Cut", "Normal", "Physical", 50, 95, 30},
+	{"Gust", "Flying", "Special", 40, 100, 35},
+	{"Wing Attack", "Flying", "Physical", 60, 100, 35},
+	{"Whirlwind", "Normal", "Status", 0, 100, 20},
+	{"Fly", "Flying", "Physical", 90, 95, 15},
+	{"Bind", "Normal", "Physical", 15, 75, 20},
+	{"Slam", "Normal", "Physical", 80, 75, 20},
+	{"Vine Whip", "Grass", "Physical", 35, 100, 15},
+	{"Stomp", "Normal", "Physical", 65, 100, 20},
+	{"Double Kick", "Fighting", "Physical", 30, 100, 30},
+	{"Mega Kick", "Normal", "Physical", 120, 75, 5},
+	{"Jump Kick", "Fighting", "Physical", 85, 95, 25},
+	{"Rolling Kick", "Fighting", "Physical", 60, 85, 15},
+	{"Sand Attack", "Ground", "Status", 0, 100, 15},
+	{"Headbutt", "Normal", "Physical", 70, 100, 15},
+	{"Horn Attack", "Normal", "Physical", 65, 100, 25},
+	{"Fury Attack", "Normal", "Physical", 15, 85, 20},
+	{"Horn Drill", "Normal", "Physical", 0, 30, 5},
+	{"Tackle", "Normal", "Physical", 35, 95, 35},
+	{"Body Slam", "Normal", "Physical", 85, 100, 15},
+	{"Wrap", "Normal", "Physical", 15, 85, 20},
+	{"Take Down", "Normal", "Physical", 90, 85, 20},
+	{"Thrash", "Normal", "Physical", 90, 100, 20},
+	{"Double-Edge", "Normal", "Physical", 120, 100, 15},
+	{"Tail Whip", "Normal", "Status", 0, 100, 30},
+	{"Poison Sting", "Poison", "Physical", 15, 100, 35},
+	{"Twineedle", "Bug", "Physical", 25, 100, 20},
+	{"Pin Missile", "Bug", "Physical", 14, 85, 20},
+	{"Leer", "Normal", "Status", 0, 100, 30},
+	{"Bite", "Dark", "Physical", 60, 100, 25},
+	{"Growl", "Normal", "Status", 0, 100, 40},
+	{"Roar", "Normal", "Status", 0, 100, 20},
+	{"Sing", "Normal", "Status", 0, 55, 15},
+	{"Supersonic", "Normal", "Status", 0, 55, 20},
+	{"Sonic Boom", "Normal", "Special", 0, 90, 20},
+	{"Disable", "Normal", "Status", 0, 80, 20},
+	{"Acid", "Poison", "Special", 40, 100, 30},
+	{"Ember", "Fire", "Special", 40, 100, 25},
+	{"Flamethrower", "Fire", "Special", 95, 100, 15},
+	{"Mist", "Ice", "Status", 0, 0, 30},
+	{"Water Gun", "Water", "Special", 40, 100, 25},
+	{"Hydro Pump", "Water", "Special", 120, 80, 5},
+	{"Surf", "Water", "Special", 95, 100, 15},
+	{"Ice Beam", "Ice", "Special", 95, 100, 10},
+	{"Blizzard", "Ice", "Special", 120, 70, 5},
+	{"Psybeam", "Psychic", "Special", 65, 100, 20},
+	{"Bubble Beam", "Water", "Special", 65, 100, 20},
+	{"Aurora Beam", "Ice", "Special", 65, 100, 20},
+	{"Hyper Beam", "Normal", "Special", 150, 90, 5},
+	{"Peck", "Flying", "Physical", 35, 100, 35},
+	{"Drill Peck", "Flying", "Physical", 80, 100, 20},
+	{"Submission", "Fighting", "Physical", 80, 80, 25},
+	{"Low Kick", "Fighting", "Physical", 0, 100, 20},
+	{"Counter", "Fighting", "Physical", 0, 100, 20},
+	{"Seismic Toss", "Fighting", "Physical", 0, 100, 20},
+	{"Strength", "Normal", "Physical", 80, 100, 15},
+	{"Absorb", "Grass", "Special", 20, 100, 25},
+	{"Mega Drain", "Grass", "Special", 40, 100, 15},
+	{"Leech Seed", "Grass", "Status", 0, 90, 10},
+	{"Growth", "Normal", "Status", 0, 0, 40},
+	{"Razor Leaf", "Grass", "Physical", 55, 95, 25},
+	{"Solar Beam", "Grass", "Special", 120, 100, 10},
+	{"Poison Powder", "Poison", "Status", 0, 75, 35},
+	{"Stun Spore", "Grass", "Status", 0, 75, 30},
+	{"Sleep Powder", "Grass", "Status", 0, 75, 15},
+	{"Petal Dance", "Grass", "Special", 90, 100, 20},
+	{"String Shot", "Bug", "Status", 0, 95, 40},
+	{"Dragon Rage", "Dragon", "Special", 0, 100, 10},
+	{"Fire Spin", "Fire", "Special", 15, 70, 15},
+	{"Thunder Shock", "Electric", "Special", 40, 100, 30},
+	{"Thunderbolt", "Electric", "Special", 95, 100, 15},
+	{"Thunder Wave", "Electric", "Status", 0, 100, 20},
+	{"Thunder", "Electric", "Special", 120, 70, 10},
+	{"Rock Throw", "Rock", "Physical", 50, 90, 15},
+	{"Earthquake", "Ground", "Physical", 100, 100, 10},
+	{"Fissure", "Ground", "Physical", 0, 30, 5},
+	{"Dig", "Ground", "Physical", 80, 100, 10},
+	{"Toxic", "Poison", "Status", 0, 85, 10},
+	{"Confusion", "Psychic", "Special", 50, 100, 25},
+	{"Psychic", "Psychic", "Special", 90, 100, 10},
+	{"Hypnosis", "Psychic", "Status", 0, 60, 20},
+	{"Meditate", "Psychic", "Status", 0, 0, 40},
+	{"Agility", "Psychic", "Status", 0, 0, 30},
+	{"Quick Attack", "Normal", "Physical", 40, 100, 30},
+	{"Rage", "Normal", "Physical", 20, 100, 20},
+	{"Teleport", "Psychic", "Status", 0, 0, 20},
+	{"Night Shade", "Ghost", "Special", 0, 100, 15},
+	{"Mimic", "Normal", "Status", 0, 0, 10},
+	{"Screech", "Normal", "Status", 0, 85, 40},
+	{"Double Team", "Normal", "Status", 0, 0, 15},
+	{"Recover", "Normal", "Status", 0, 0, 10},
+	{"Harden", "Normal", "Status", 0, 0, 30},
+	{"Minimize", "Normal", "Status", 0, 0, 20},
+	{"Smokescreen", "Normal", "Status", 0, 100, 20},
+	{"Confuse Ray", "Ghost", "Status", 0, 100, 10},
+	{"Withdraw", "Water", "Status", 0, 0, 40},
+	{"Defense Curl", "Normal", "Status", 0, 0, 40},
+	{"Barrier", "Psychic", "Status", 0, 0, 30},
+	{"Light Screen", "Psychic", "Status", 0, 0, 30},
+	{"Haze", "Ice", "Status", 0, 0, 30},
+	{"Reflect", "Psychic", "Status", 0, 0, 20},
+	{"Focus Energy", "Normal", "Status", 0, 0, 30},
+	{"Bide", "Normal", "Physical", 0, 0, 10},
+	{"Metronome", "Normal", "Status", 0, 0, 10},
+	{"Mirror Move", "Flying", "Status", 0, 0, 20},
+	{"Self-Destruct", "Normal", "Physical", 200, 100, 5},
+	{"Egg Bomb", "Normal", "Physical", 100, 75, 10},
+	{"Lick", "Ghost", "Physical", 20, 100, 30},
+	{"Smog", "Poison", "Special", 20, 70, 20},
+	{"Sludge", "Poison", "Special", 65, 100, 20},
+	{"Bone Club", "Ground", "Physical", 65, 85, 20},
+	{"Fire Blast", "Fire", "Special", 120, 85, 5},
+	{"Waterfall", "Water", "Physical", 80, 100, 15},
+	{"Clamp", "Water", "Physical", 35, 75, 10},
+	{"Swift", "Normal", "Special", 60, 0, 20},
+	{"Skull Bash", "Normal", "Physical", 100, 100, 15},
+	{"Spike Cannon", "Normal", "Physical", 20, 100, 15},
+	{"Constrict", "Normal", "Physical", 10, 100, 35},
+	{"Amnesia", "Psychic", "Status", 0, 0, 20},
+	{"Kinesis", "Psychic", "Status", 0, 80, 15},
+	{"Soft-Boiled", "Normal", "Status", 0, 0, 10},
+	{"High Jump Kick", "Fighting", "Physical", 100, 90, 20},
+	{"Glare", "Normal", "Status", 0, 75, 30},
+	{"Dream Eater", "Psychic", "Special", 100, 100, 15},
+	{"Poison Gas", "Poison", "Status", 0, 55, 40},
+	{"Barrage", "Normal", "Physical", 15, 85, 20},
+	{"Leech Life", "Bug", "Physical", 20, 100, 15},
+	{"Lovely Kiss", "Normal", "Status", 0, 75, 10},
+	{"Sky Attack", "Flying", "Physical", 140, 90, 5},
+	{"Transform", "Normal", "Status", 0, 0, 10},
+	{"Bubble", "Water", "Special", 20, 100, 30},
+	{"Dizzy Punch", "Normal", "Physical", 70, 100, 10},
+	{"Spore", "Grass", "Status", 0, 100, 15},
+	{"Flash", "Normal", "Status", 0, 100, 20},
+	{"Psywave", "Psychic", "Special", 0, 80, 15},
+	{"Splash", "Normal", "Status", 0, 0, 40},
+	{"Acid Armor", "Poison", "Status", 0, 0, 40},
+	{"Crabhammer", "Water", "Physical", 90, 85, 10},
+	{"Explosion", "Normal", "Physical", 250, 100, 5},
+	{"Fury Swipes", "Normal", "Physical", 18, 80, 15},
+	{"Bonemerang", "Ground", "Physical", 50, 90, 10},
+	{"Rest", "Psychic", "Status", 0, 0, 10},
+	{"Rock Slide", "Rock", "Physical", 75, 90, 10},
+	{"Hyper Fang", "Normal", "Physical", 80, 90, 15},
+	{"Sharpen", "Normal", "Status", 0, 0, 30},
+	{"Conversion", "Normal", "Status", 0, 0, 30},
+	{"Tri Attack", "Normal", "Special", 80, 100, 10},
+	{"Super Fang", "Normal", "Physical", 0, 90, 10},
+	{"Slash", "Normal", "Physical", 70, 100, 20},
+	{"Substitute", "Normal", "Status", 0, 0, 10},
+	{"Struggle", "Normal", "Physical", 50, 0, 1},
+	{"Sketch", "Normal", "Status", 0, 0, 1},
+	{"Triple Kick", "Fighting", "Physical", 10, 90, 10},
+	{"Thief", "Dark", "Physical", 40, 100, 10},
+	{"Spider Web", "Bug", "Status", 0, 0, 10},
+	{"Mind Reader", "Normal", "Status", 0, 100, 5},
+	{"Nightmare", "Ghost", "Status", 0, 100, 15},
+	{"Flame Wheel", "Fire", "Physical", 60, 100, 25},
+	{"Snore", "Normal", "Special", 40, 100, 15},
+	{"Curse", "???", "Status", 0, 0, 10},
+	{"Flail", "Normal", "Physical", 0, 100, 15},
+	{"Conversion 2", "Normal", "Status", 0, 100, 30},
+	{"Aeroblast", "Flying", "Special", 100, 95, 5},
+	{"Cotton Spore", "Grass", "Status", 0, 85, 40},
+	{"Reversal", "Fighting", "Physical", 0, 100, 15},
+	{"Spite", "Ghost", "Status", 0, 100, 10},
+	{"Powder Snow", "Ice", "Special", 40, 100, 25},
+	{"Protect", "Normal", "Status", 0, 0, 10},
+	{"Mach Punch", "Fighting", "Physical", 40, 100, 30},
+	{"Scary Face", "Normal", "Status", 0, 90, 10},
+	{"Feint Attack", "Dark", "Physical", 60, 0, 20},
+	{"Sweet Kiss", "Normal", "Status", 0, 75, 10},
+	{"Belly Drum", "Normal", "Status", 0, 0, 10},
+	{"Sludge Bomb", "Poison", "Special", 90, 100, 10},
+	{"Mud-Slap", "Ground", "Special", 20, 100, 10},
+	{"Octazooka", "Water", "Special", 65, 85, 10},
+	{"Spikes", "Ground", "Status", 0, 0, 20},
+	{"Zap Cannon", "Electric", "Special", 120, 50, 5},
+	{"Foresight", "Normal", "Status", 0, 100, 40},
+	{"Destiny Bond", "Ghost", "Status", 0, 0, 5},
+	{"Perish Song", "Normal", "Status", 0, 0, 5},
+	{"Icy Wind", "Ice", "Special", 55, 95, 15},
+	{"Detect", "Fighting", "Status", 0, 0, 5},
+	{"Bone Rush", "Ground", "Physical", 25, 80, 10},
+	{"Lock-On", "Normal", "Status", 0, 100, 5},
+	{"Outrage", "Dragon", "Physical", 120, 100, 15},
+	{"Sandstorm", "Rock", "Status", 0, 0, 10},
+	{"Giga Drain", "Grass", "Special", 60, 100, 10},
+	{"Endure", "Normal", "Status", 0, 0, 10},
+	{"Charm", "Normal", "Status", 0, 100, 20},
+	{"Rollout", "Rock", "Physical", 30, 90, 20},
+	{"False Swipe", "Normal", "Physical", 40, 100, 40},
+	{"Swagger", "Normal", "Status", 0, 90, 15},
+	{"Milk Drink", "Normal", "Status", 0, 0, 10},
+	{"Spark", "Electric", "Physical", 65, 100, 20},
+	{"Fury Cutter", "Bug", "Physical", 10, 95, 20},
+	{"Steel Wing", "Steel", "Physical", 70, 90, 25},
+	{"Mean Look", "Normal", "Status", 0, 0, 5},
+	{"Attract", "Normal", "Status", 0, 100, 15},
+	{"Sleep Talk", "Normal", "Status", 0, 0, 10},
+	{"Heal Bell", "Normal", "Status", 0, 0, 5},
+	{"Return", "Normal", "Physical", 0, 100, 20},
+	{"Present", "Normal", "Physical", 0, 90, 15},
+	{"Frustration", "Normal", "Physical", 0, 100, 20},
+	{"Safeguard", "Normal", "Status", 0, 0, 25},
+	{"Pain Split", "Normal", "Status", 0, 0, 20},
+	{"Sacred Fire", "Fire", "Physical", 100, 95, 5},
+	{"Magnitude", "Ground", "Physical", 0, 100, 30},
+	{"Dynamic Punch", "Fighting", "Physical", 100, 50, 5},
+	{"Megahorn", "Bug", "Physical", 120, 85, 10},
+	{"Dragon Breath", "Dragon", "Special", 60, 100, 20},
+	{"Baton Pass", "Normal", "Status", 0, 0, 40},
+	{"Encore", "Normal", "Status", 0, 100, 5},
+	{"Pursuit", "Dark", "Physical", 40, 100, 20},
+	{"Rapid Spin", "Normal", "Physical", 20, 100, 40},
+	{"Sweet Scent", "Normal", "Status", 0, 100, 20},
+	{"Iron Tail", "Steel", "Physical", 100, 75, 15},
+	{"Metal Claw", "Steel", "Physical", 50, 95, 35},
+	{"Vital Throw", "Fighting", "Physical", 70, 0, 10},
+	{"Morning Sun", "Normal", "Status", 0, 0, 5},
+	{"Synthesis", "Grass", "Status", 0, 0, 5},
+	{"Moonlight", "Normal", "Status", 0, 0, 5},
+	{"Hidden Power", "Normal", "Special", 0, 100, 15},
+	{"Cross Chop", "Fighting", "Physical", 100, 80, 5},
+	{"Twister", "Dragon", "Special", 40, 100, 20},
+	{"Rain Dance", "Water", "Status", 0, 0, 5},
+	{"Sunny Day", "Fire", "Status", 0, 0, 5},
+	{"Crunch", "Dark", "Physical", 80, 100, 15},
+	{"Mirror Coat", "Psychic", "Special", 0, 100, 20},
+	{"Psych Up", "Normal", "Status", 0, 0, 10},
+	{"Extreme Speed", "Normal", "Physical", 80, 100, 5},
+	{"Ancient Power", "Rock", "Special", 60, 100, 5},
+	{"Shadow Ball", "Ghost", "Special", 80, 100, 15},
+	{"Future Sight", "Psychic", "Special", 80, 90, 15},
+	{"Rock Smash", "Fighting", "Physical", 40, 100, 15},
+	{"Whirlpool", "Water", "Special", 15, 70, 15},
+	{"Beat Up", "Dark", "Physical", 10, 100, 10},
+	{"Fake Out", "Normal", "Physical", 40, 100, 10},
+	{"Uproar", "Normal", "Special", 50, 100, 10},
+	{"Stockpile", "Normal", "Status", 0, 0, 20},
+	{"Spit Up", "Normal", "Special", 0, 100, 10},
+	{"Swallow", "Normal", "Status", 0, 0, 10},
+	{"Heat Wave", "Fire", "Special", 100, 90, 10},
+	{"Hail", "Ice", "Status", 0, 0, 10},
+	{"Torment", "Dark", "Status", 0, 100, 15},
+	{"Flatter", "Dark", "Status", 0, 100, 15},
+	{"Will-O-Wisp", "Fire", "Status", 0, 75, 15},
+	{"Memento", "Dark", "Status", 0, 100, 10},
+	{"Facade", "Normal", "Physical", 70, 100, 20},
+	{"Focus Punch", "Fighting", "Physical", 150, 100, 20},
+	{"Smelling Salts", "Normal", "Physical", 60, 100, 10},
+	{"Follow Me", "Normal", "Status", 0, 0, 20},
+	{"Nature Power", "Normal", "Status", 0, 0, 20},
+	{"Charge", "Electric", "Status", 0, 0, 20},
+	{"Taunt", "Dark", "Status", 0, 100, 20},
+	{"Helping Hand", "Normal", "Status", 0, 0, 20},
+	{"Trick", "Psychic", "Status", 0, 100, 10},
+	{"Role Play", "Psychic", "Status", 0, 0, 10},
+	{"Wish", "Normal", "Status", 0, 0, 10},
+	{"Assist", "Normal", "Status", 0, 0, 20},
+	{"Ingrain", "Grass", "Status", 0, 0, 20},
+	{"Superpower", "Fighting", "Physical", 120, 100, 5},
+	{"Magic Coat", "Psychic", "Status", 0, 0, 15},
+	{"Recycle", "Normal", "Status", 0, 0, 10},
+	{"Revenge", "Fighting", "Physical", 60, 100, 10},
+	{"Brick Break", "Fighting", "Physical", 75, 100, 15},
+	{"Yawn", "Normal", "Status", 0, 0, 10},
+	{"Knock Off", "Dark", "Physical", 20, 100, 20},
+	{"Endeavor", "Normal", "Physical", 0, 100, 5},
+	{"Eruption", "Fire", "Special", 150, 100, 5},
+	{"Skill Swap", "Psychic", "Status", 0, 0, 10},
+	{"Imprison", "Psychic", "Status", 0, 0, 10},
+	{"Refresh", "Normal", "Status", 0, 0, 20},
+	{"Grudge", "Ghost", "Status", 0, 0, 5},
+	{"Snatch", "Dark", "Status", 0, 0, 10},
+	{"Secret Power", "Normal", "Physical", 70, 100, 20},
+	{"Dive", "Water", "Physical", 80, 100, 10},
+	{"Arm Thrust", "Fighting", "Physical", 15, 100, 20},
+	{"Camouflage", "Normal", "Status", 0, 0, 20},
+	{"Tail Glow", "Bug", "Status", 0, 0, 20},
+	{"Luster Purge", "Psychic", "Special", 70, 100, 5},
+	{"Mist Ball", "Psychic", "Special", 70, 100, 5},
+	{"Feather Dance", "Flying", "Status", 0, 100, 15},
+	{"Teeter Dance", "Normal", "Status", 0, 100, 20},
+	{"Blaze Kick", "Fire", "Physical", 85, 90, 10},
+	{"Mud Sport", "Ground", "Status", 0, 0, 15},
+	{"Ice Ball", "Ice", "Physical", 30, 90, 20},
+	{"Needle Arm", "Grass", "Physical", 60, 100, 15},
+	{"Slack Off", "Normal", "Status", 0, 0, 10},
+	{"Hyper Voice", "Normal", "Special", 90, 100, 10},
+	{"Poison Fang", "Poison", "Physical", 50, 100, 15},
+	{"Crush Claw", "Normal", "Physical", 75, 95, 10},
+	{"Blast Burn", "Fire", "Special", 150, 90, 5},
+	{"Hydro Cannon", "Water", "Special", 150, 90, 5},
+	{"Meteor Mash", "Steel", "Physical", 100, 85, 10},
+	{"Astonish", "Ghost", "Physical", 30, 100, 15},
+	{"Weather Ball", "Normal", "Special", 50, 100, 10},
+	{"Aromatherapy", "Grass", "Status", 0, 0, 5},
+	{"Fake Tears", "Dark", "Status", 0, 100, 20},
+	{"Air Cutter", "Flying", "Special", 55, 95, 25},
+	{"Overheat", "Fire", "Special", 140, 90, 5},
+	{"Odor Sleuth", "Normal", "Status", 0, 100, 40},
+	{"Rock Tomb", "Rock", "Physical", 50, 80, 10},
+	{"Silver Wind", "Bug", "Special", 60, 100, 5},
+	{"Metal Sound", "Steel", "Status", 0, 85, 40},
+	{"Grass Whistle", "Grass", "Status", 0, 55, 15},
+	{"Tickle", "Normal", "Status", 0, 100, 20},
+	{"Cosmic Power", "Psychic", "Status", 0, 0, 20},
+	{"Water Spout", "Water", "Special", 150, 100, 5},
+	{"Signal Beam", "Bug", "Special", 75, 100, 15},
+	{"Shadow Punch", "Ghost", "Physical", 60, 0, 20},
+	{"Extrasensory", "Psychic", "Special", 80, 100, 30},
+	{"Sky Uppercut", "Fighting", "Physical", 85, 90, 15},
+	{"Sand Tomb", "Ground", "Physical", 15, 70, 15},
+	{"Sheer Cold", "Ice", "Special", 0, 30, 5},
+	{"Muddy Water", "Water", "Special", 95, 85, 10},
+	{"Bullet Seed", "Grass", "Physical", 10, 100, 30},
+	{"Aerial Ace", "Flying", "Physical", 60, 0, 20},
+	{"Icicle Spear", "Ice", "Physical", 10, 100, 30},
+	{"Iron Defense", "Steel", "Status", 0, 0, 15},
+	{"Block", "Normal", "Status", 0, 0, 5},
+	{"Howl", "Normal", "Status", 0, 0, 40},
+	{"Dragon Claw", "Dragon", "Physical", 80, 100, 15},
+	{"Frenzy Plant", "Grass", "Special", 150, 90, 5},
+	{"Bulk Up", "Fighting", "Status", 0, 0, 20},
+	{"Bounce", "Flying", "Physical", 85, 85, 5},
+	{"Mud Shot", "Ground", "Special", 55, 95, 15},
+	{"Poison Tail", "Poison", "Physical", 50, 100, 25},
+	{"Covet", "Normal", "Physical", 40, 100, 40},
+	{"Volt Tackle", "Electric", "Physical", 120, 100, 15},
+	{"Magical Leaf", "Grass", "Special", 60, 0, 20},
+	{"Water Sport", "Water", "Status", 0, 0, 15},
+	{"Calm Mind", "Psychic", "Status", 0, 0, 20},
+	{"Leaf Blade", "Grass", "Physical", 90, 100, 15},
+	{"Dragon Dance", "Dragon", "Status", 0, 0, 20},
+	{"Rock Blast", "Rock", "Physical", 25, 80, 10},
+	{"Shock Wave", "Electric", "Special", 60, 0, 20},
+	{"Water Pulse", "Water", "Special", 60, 100, 20},
+	{"Doom Desire", "Steel", "Special", 120, 85, 5},
+	{"Psycho Boost", "Psychic", "Special", 140, 90, 5},
+	{"Roost", "Flying", "Status", 0, 0, 10},
+	{"Gravity", "Psychic", "Status", 0, 0, 5},
+	{"Miracle Eye", "Psychic", "Status", 0, 0, 40},
+	{"Wake-Up Slap", "Fighting", "Physical", 60, 100, 10},
+	{"Hammer Arm", "Fighting", "Physical", 100, 90, 10},
+	{"Gyro Ball", "Steel", "Physical", 0, 100, 5},
+	{"Healing Wish", "Psychic", "Status", 0, 0, 10},
+	{"Brine", "Water", "Special", 65, 100, 10},
+	{"Natural Gift", "Normal", "Physical", 0, 100, 15},
+	{"Feint", "Normal", "Physical", 50, 100, 10},
+	{"Pluck", "Flying", "Physical", 60, 100, 20},
+	{"Tailwind", "Flying", "Status", 0, 0, 30},
+	{"Acupressure", "Normal", "Status", 0, 0, 30},
+	{"Metal Burst", "Steel", "Physical", 0, 100, 10},
+	{"U-turn", "Bug", "Physical", 70, 100, 20},
+	{"Close Combat", "Fighting", "Physical", 120, 100, 5},
+	{"Payback", "Dark", "Physical", 50, 100, 10},
+	{"Assurance", "Dark", "Physical", 50, 100, 10},
+	{"Embargo", "Dark", "Status", 0, 100, 15},
+	{"Fling", "Dark", "Physical", 0, 100, 10},
+	{"Psycho Shift", "Psychic", "Status", 0, 90, 10},
+	{"Trump Card", "Normal", "Special", 0, 0, 5},
+	{"Heal Block", "Psychic", "Status", 0, 100, 15},
+	{"Wring Out", "Normal", "Special", 0, 100, 5},
+	{"Power Trick", "Psychic", "Status", 0, 0, 10},
+	{"Gastro Acid", "Poison", "Status", 0, 100, 10},
+	{"Lucky Chant", "Normal", "Status", 0, 0, 30},
+	{"Me First", "Normal", "Status", 0, 0, 20},
+	{"Copycat", "Normal", "Status", 0, 0, 20},
+	{"Power Swap", "Psychic", "Status", 0, 0, 10},
+	{"Guard Swap", "Psychic", "Status", 0, 0, 10},
+	{"Punishment", "Dark", "Physical", 0, 100, 5},
+	{"Last Resort", "Normal", "Physical", 130, 100, 5},
+	{"Worry Seed", "Grass", "Status", 0, 100, 10},
+	{"Sucker Punch", "Dark", "Physical", 80, 100, 5},
+	{"Toxic Spikes", "Poison", "Status", 0, 0, 20},
+	{"Heart Swap", "Psychic", "Status", 0, 0, 10},
+	{"Aqua Ring", "Water", "Status", 0, 0, 20},
+	{"Magnet Rise", "Electric", "Status", 0, 0, 10},
+	{"Flare Blitz", "Fire", "Physical", 120, 100, 15},
+	{"Force Palm", "Fighting", "Physical", 60, 100, 10},
+	{"Aura Sphere", "Fighting", "Special", 90, 0, 20},
+	{"Rock Polish", "Rock", "Status", 0, 0, 20},
+	{"Poison Jab", "Poison", "Physical", 80, 100, 20},
+	{"Dark Pulse", "Dark", "Special", 80, 100, 15},
+	{"Night Slash", "Dark", "Physical", 70, 100, 15},
+	{"Aqua Tail", "Water", "Physical", 90, 90, 10},
+	{"Seed Bomb", "Grass", "Physical", 80, 100, 15},
+	{"Air Slash", "Flying", "Special", 75, 95, 20},
+	{"X-Scissor", "Bug", "Physical", 80, 100, 15},
+	{"Bug Buzz", "Bug", "Special", 90, 100, 10},
+	{"Dragon Pulse", "Dragon", "Special", 90, 100, 10},
+	{"Dragon Rush", "Dragon", "Physical", 100, 75, 10},
+	{"Power Gem", "Rock", "Special", 70, 100, 20},
+	{"Drain Punch", "Fighting", "Physical", 60, 100, 5},
+	{"Vacuum Wave", "Fighting", "Special", 40, 100, 30},
+	{"Focus Blast", "Fighting", "Special", 120, 70, 5},
+	{"Energy Ball", "Grass", "Special", 80, 100, 10},
+	{"Brave Bird", "Flying", "Physical", 120, 100, 15},
+	{"Earth Power", "Ground", "Special", 90, 100, 10},
+	{"Switcheroo", "Dark", "Status", 0, 100, 10},
+	{"Giga Impact", "Normal", "Physical", 150, 90, 5},
+	{"Nasty Plot", "Dark", "Status", 0, 0, 20},
+	{"Bullet Punch", "Steel", "Physical", 40, 100, 30},
+	{"Avalanche", "Ice", "Physical", 60, 100, 10},
+	{"Ice Shard", "Ice", "Physical", 40, 100, 30},
+	{"Shadow Claw", "Ghost", "Physical", 70, 100, 15},
+	{"Thunder Fang", "Electric", "Physical", 65, 95, 15},
+	{"Ice Fang", "Ice", "Physical", 65, 95, 15},
+	{"Fire Fang", "Fire", "Physical", 65, 95, 15},
+	{"Shadow Sneak", "Ghost", "Physical", 40, 100, 30},
+	{"Mud Bomb", "Ground", "Special", 65, 85, 10},
+	{"Psycho Cut", "Psychic", "Physical", 70, 100, 20},
+	{"Zen Headbutt", "Psychic", "Physical", 80, 90, 15},
+	{"Mirror Shot", "Steel", "Special", 65, 85, 10},
+	{"Flash Cannon", "Steel", "Special", 80, 100, 10},
+	{"Rock Climb", "Normal", "Physical", 90, 85, 20},
+	{"Defog", "Flying", "Status", 0, 0, 15},
+	{"Trick Room", "Psychic", "Status", 0, 0, 5},
+	{"Draco Meteor", "Dragon", "Special", 140, 90, 5},
+	{"Discharge", "Electric", "Special", 80, 100, 15},
+	{"Lava Plume", "Fire", "Special", 80, 100, 15},
+	{"Leaf Storm", "Grass", "Special", 140, 90, 5},
+	{"Power Whip", "Grass", "Physical", 120, 85, 10},
+	{"Rock Wrecker", "Rock", "Physical", 150, 90, 5},
+	{"Cross Poison", "Poison", "Physical", 70, 100, 20},
+	{"Gunk Shot", "Poison", "Physical", 120, 70, 5},
+	{"Iron Head", "Steel", "Physical", 80, 100, 15},
+	{"Magnet Bomb", "Steel", "Physical", 60, 0, 20},
+	{"Stone Edge", "Rock", "Physical", 100, 80, 5},
+	{"Captivate", "Normal", "Status", 0, 100, 20},
+	{"Stealth Rock", "Rock", "Status", 0, 0, 20},
+	{"Grass Knot", "Grass", "Special", 0, 100, 20},
+	{"Chatter", "Flying", "Special", 60, 100, 20},
+	{"Judgment", "Normal", "Special", 100, 100, 10},
+	{"Bug Bite", "Bug", "Physical", 60, 100, 20},
+	{"Charge Beam", "Electric", "Special", 50, 90, 10},
+	{"Wood Hammer", "Grass", "Physical", 120, 100, 15},
+	{"Aqua Jet", "Water", "Physical", 40, 100, 20},
+	{"Attack Order", "Bug", "Physical", 90, 100, 15},
+	{"Defend Order", "Bug", "Status", 0, 0, 10},
+	{"Heal Order", "Bug", "Status", 0, 0, 10},
+	{"Head Smash", "Rock", "Physical", 150, 80, 5},
+	{"Double Hit", "Normal", "Physical", 35, 90, 10},
+	{"Roar of Time", "Dragon", "Special", 150, 90, 5},
+	{"Spacial Rend", "Dragon", "Special", 100, 95, 5},
+	{"Lunar Dance", "Psychic", "Status", 0, 0, 10},
+	{"Crush Grip", "Normal", "Physical", 0, 100, 5},
+	{"Magma Storm", "Fire", "Special", 120, 70, 5},
+	{"Dark Void", "Dark", "Status", 0, 80, 10},
+	{"Seed Flare", "Grass", "Special", 120, 85, 5},
+	{"Ominous Wind", "Ghost", "Special", 60, 100, 5},
+	{"Shadow Force", "Ghost", "Physical", 120, 100, 5},
+}
+
+func GetMove(index uint16) (MoveInfo, error) {
+	if index >= uint16(len(moveTable)) {
+		return MoveInfo{}, errors.New("invalid index")
+	}
+
+	return moveTable[index], nil
+}
+
+// maps move names to their index. The empty slot placeholder is left out
+func GenerateMoveMap() map[string]uint16 {
+	moveMap := make(map[string]uint16)
+
+	for i, m := range moveTable[1:] {
+		moveMap[m.Name] = uint16(i + 1)
+	}
+
+	return moveMap
+}
+
+// each PP Up raises the base PP by a fifth, up to 3 PP Ups
+func MaxPP(index uint16, ppUps uint) (uint, error) {
+	if ppUps > 3 {
+		return 0, fmt.Errorf("PP Ups must be <= 3")
+	}
+
+	move, err := GetMove(index)
+	if err != nil {
+		return 0, err
+	}
+
+	return move.PP + move.PP*ppUps/5, nil
+}
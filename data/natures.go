@@ -53,4 +53,15 @@ func GenerateNatureMap() map[string][5]uint {
 	}
 
 	return natureInfo
-}
\ No newline at end of file
+}
+
+// maps nature names to their index, which is personality % 25 in gen 4 games
+func GenerateNatureIndexMap() map[string]uint {
+	natureIndexes := make(map[string]uint)
+
+	for i, n := range natureTable {
+		natureIndexes[n] = uint(i)
+	}
+
+	return natureIndexes
+}
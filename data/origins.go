@@ -0,0 +1,68 @@
+package data
+
+import "fmt"
+
+// game IDs, as stored in a pokemon's origin game field
+var gameTable map[uint8]string = map[uint8]string{
+	1:  "Sapphire",
+	2:  "Ruby",
+	3:  "Emerald",
+	4:  "FireRed",
+	5:  "LeafGreen",
+	7:  "HeartGold",
+	8:  "SoulSilver",
+	10: "Diamond",
+	11: "Pearl",
+	12: "Platinum",
+	15: "Colosseum/XD",
+	20: "White",
+	21: "Black",
+	22: "White 2",
+	23: "Black 2",
+}
+
+var languageTable map[uint8]string = map[uint8]string{
+	1: "Japanese",
+	2: "English",
+	3: "French",
+	4: "Italian",
+	5: "German",
+	7: "Spanish",
+	8: "Korean",
+}
+
+func GetGame(id uint8) (string, error) {
+	game, ok := gameTable[id]
+	if !ok {
+		return "", fmt.Errorf("invalid game ID: %d", id)
+	}
+
+	return game, nil
+}
+
+func GetLanguage(id uint8) (string, error) {
+	language, ok := languageTable[id]
+	if !ok {
+		return "", fmt.Errorf("invalid language ID: %d", id)
+	}
+
+	return language, nil
+}
+
+// Ball IDs match the item IDs up to the Cherish Ball. The HGSS apricorn balls come after it
+func GetBall(id uint8) (string, error) {
+	if id > 16 {
+		item, err := GetItem(uint16(id) - 17 + 492)
+		if err != nil || id > 25 {
+			return "", fmt.Errorf("invalid ball ID: %d", id)
+		}
+		return item.Name, nil
+	}
+
+	item, err := GetItem(uint16(id))
+	if err != nil {
+		return "", err
+	}
+
+	return item.Name, nil
+}
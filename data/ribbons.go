@@ -0,0 +1,53 @@
+package data
+
+// one bit per ribbon, least significant bit first. Empty strings are unused bits
+var sinnohRibbonsTable1 [32]string = [32]string{
+	"Sinnoh Champ", "Ability", "Great Ability", "Double Ability",
+	"Multi Ability", "Pair Ability", "World Ability", "Alert",
+	"Shock", "Downcast", "Careless", "Relax",
+	"Snooze", "Smile", "Gorgeous", "Royal",
+	"Gorgeous Royal", "Footprint", "Record", "History",
+	"Legend", "Red", "Green", "Blue",
+	"Festival", "Carnival", "Classic", "Premier",
+}
+
+var hoennRibbonsTable [32]string = [32]string{
+	"Cool (Hoenn)", "Cool Super (Hoenn)", "Cool Hyper (Hoenn)", "Cool Master (Hoenn)",
+	"Beauty (Hoenn)", "Beauty Super (Hoenn)", "Beauty Hyper (Hoenn)", "Beauty Master (Hoenn)",
+	"Cute (Hoenn)", "Cute Super (Hoenn)", "Cute Hyper (Hoenn)", "Cute Master (Hoenn)",
+	"Smart (Hoenn)", "Smart Super (Hoenn)", "Smart Hyper (Hoenn)", "Smart Master (Hoenn)",
+	"Tough (Hoenn)", "Tough Super (Hoenn)", "Tough Hyper (Hoenn)", "Tough Master (Hoenn)",
+	"Champion", "Winning", "Victory", "Artist",
+	"Effort", "Marine", "Land", "Sky",
+	"Country", "National", "Earth", "World",
+}
+
+var sinnohRibbonsTable2 [32]string = [32]string{
+	"Cool (Sinnoh)", "Cool Great (Sinnoh)", "Cool Ultra (Sinnoh)", "Cool Master (Sinnoh)",
+	"Beauty (Sinnoh)", "Beauty Great (Sinnoh)", "Beauty Ultra (Sinnoh)", "Beauty Master (Sinnoh)",
+	"Cute (Sinnoh)", "Cute Great (Sinnoh)", "Cute Ultra (Sinnoh)", "Cute Master (Sinnoh)",
+	"Smart (Sinnoh)", "Smart Great (Sinnoh)", "Smart Ultra (Sinnoh)", "Smart Master (Sinnoh)",
+	"Tough (Sinnoh)", "Tough Great (Sinnoh)", "Tough Ultra (Sinnoh)", "Tough Master (Sinnoh)",
+}
+
+// Lists the ribbons set in the three ribbon bitfields of a pokemon
+func GetRibbons(sinnoh1, hoenn, sinnoh2 uint32) []string {
+	ribbons := make([]string, 0)
+
+	for _, set := range []struct {
+		flags uint32
+		names *[32]string
+	}{
+		{sinnoh1, &sinnohRibbonsTable1},
+		{hoenn, &hoennRibbonsTable},
+		{sinnoh2, &sinnohRibbonsTable2},
+	} {
+		for i, name := range set.names {
+			if name != "" && set.flags&(1<<i) != 0 {
+				ribbons = append(ribbons, name)
+			}
+		}
+	}
+
+	return ribbons
+}
@@ -0,0 +1,563 @@
+package data
+
+import "errors"
+
+// growth rates, which pick the experience curve of a species
+const (
+	ERRATIC     = "Erratic"
+	FAST        = "Fast"
+	MEDIUM_FAST = "Medium Fast"
+	MEDIUM_SLOW = "Medium Slow"
+	SLOW        = "Slow"
+	FLUCTUATING = "Fluctuating"
+)
+
+// gender ratios with a fixed gender. Any other ratio is the threshold the low byte
+// of the personality value is compared against: the pokemon is female below it
+const (
+	MALE_ONLY   = 0
+	FEMALE_ONLY = 254
+	GENDERLESS  = 255
+)
+
+type BaseStats struct {
+	Hp        uint
+	Attack    uint
+	Defense   uint
+	SpAttack  uint
+	SpDefense uint
+	Speed     uint
+}
+
+// the second type, ability and egg group are empty strings when the species only has one
+type SpeciesInfo struct {
+	Name        string
+	Types       [2]string
+	BaseStats   BaseStats
+	Abilities   [2]string
+	GrowthRate  string
+	GenderRatio uint8
+	EggGroups   [2]string
+	CatchRate   uint8
+}
+
+var speciesTable [494]SpeciesInfo = [494]SpeciesInfo{
+	{}, // placeholder to account for 1-based pokedex IDs
+	{"Bulbasaur", [2]string{"Grass", "Poison"}, BaseStats{45, 49, 49, 65, 65, 45}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Ivysaur", [2]string{"Grass", "Poison"}, BaseStats{60, 62, 63, 80, 80, 60}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Venusaur", [2]string{"Grass", "Poison"}, BaseStats{80, 82, 83, 100, 100, 80}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Charmander", [2]string{"Fire", ""}, BaseStats{39, 52, 43, 60, 50, 65}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Dragon"}, 45},
+	{"Charmeleon", [2]string{"Fire", ""}, BaseStats{58, 64, 58, 80, 65, 80}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Dragon"}, 45},
+	{"Charizard", [2]string{"Fire", "Flying"}, BaseStats{78, 84, 78, 109, 85, 100}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Dragon"}, 45},
+	{"Squirtle", [2]string{"Water", ""}, BaseStats{44, 48, 65, 50, 64, 43}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Wartortle", [2]string{"Water", ""}, BaseStats{59, 63, 80, 65, 80, 58}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Blastoise", [2]string{"Water", ""}, BaseStats{79, 83, 100, 85, 105, 78}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Caterpie", [2]string{"Bug", ""}, BaseStats{45, 30, 35, 20, 20, 45}, [2]string{"Shield Dust", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 255},
+	{"Metapod", [2]string{"Bug", ""}, BaseStats{50, 20, 55, 25, 25, 30}, [2]string{"Shed Skin", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 120},
+	{"Butterfree", [2]string{"Bug", "Flying"}, BaseStats{60, 45, 50, 80, 80, 70}, [2]string{"Compound Eyes", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 45},
+	{"Weedle", [2]string{"Bug", "Poison"}, BaseStats{40, 35, 30, 20, 20, 50}, [2]string{"Shield Dust", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 255},
+	{"Kakuna", [2]string{"Bug", "Poison"}, BaseStats{45, 25, 50, 25, 25, 35}, [2]string{"Shed Skin", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 120},
+	{"Beedrill", [2]string{"Bug", "Poison"}, BaseStats{65, 80, 40, 45, 80, 75}, [2]string{"Swarm", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 45},
+	{"Pidgey", [2]string{"Normal", "Flying"}, BaseStats{40, 45, 40, 35, 35, 56}, [2]string{"Keen Eye", "Tangled Feet"}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 255},
+	{"Pidgeotto", [2]string{"Normal", "Flying"}, BaseStats{63, 60, 55, 50, 50, 71}, [2]string{"Keen Eye", "Tangled Feet"}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 120},
+	{"Pidgeot", [2]string{"Normal", "Flying"}, BaseStats{83, 80, 75, 70, 70, 91}, [2]string{"Keen Eye", "Tangled Feet"}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 45},
+	{"Rattata", [2]string{"Normal", ""}, BaseStats{30, 56, 35, 25, 35, 72}, [2]string{"Run Away", "Guts"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Raticate", [2]string{"Normal", ""}, BaseStats{55, 81, 60, 50, 70, 97}, [2]string{"Run Away", "Guts"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 127},
+	{"Spearow", [2]string{"Normal", "Flying"}, BaseStats{40, 60, 30, 31, 31, 70}, [2]string{"Keen Eye", ""}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 255},
+	{"Fearow", [2]string{"Normal", "Flying"}, BaseStats{65, 90, 65, 61, 61, 100}, [2]string{"Keen Eye", ""}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 90},
+	{"Ekans", [2]string{"Poison", ""}, BaseStats{35, 60, 44, 40, 54, 55}, [2]string{"Intimidate", "Shed Skin"}, MEDIUM_FAST, 127, [2]string{"Field", "Dragon"}, 255},
+	{"Arbok", [2]string{"Poison", ""}, BaseStats{60, 85, 69, 65, 79, 80}, [2]string{"Intimidate", "Shed Skin"}, MEDIUM_FAST, 127, [2]string{"Field", "Dragon"}, 90},
+	{"Pikachu", [2]string{"Electric", ""}, BaseStats{35, 55, 30, 50, 40, 90}, [2]string{"Static", ""}, MEDIUM_FAST, 127, [2]string{"Field", "Fairy"}, 190},
+	{"Raichu", [2]string{"Electric", ""}, BaseStats{60, 90, 55, 90, 80, 100}, [2]string{"Static", ""}, MEDIUM_FAST, 127, [2]string{"Field", "Fairy"}, 75},
+	{"Sandshrew", [2]string{"Ground", ""}, BaseStats{50, 75, 85, 20, 30, 40}, [2]string{"Sand Veil", ""}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Sandslash", [2]string{"Ground", ""}, BaseStats{75, 100, 110, 45, 55, 65}, [2]string{"Sand Veil", ""}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 90},
+	{"Nidoran♀", [2]string{"Poison", ""}, BaseStats{55, 47, 52, 40, 40, 41}, [2]string{"Poison Point", "Rivalry"}, MEDIUM_SLOW, FEMALE_ONLY, [2]string{"Monster", "Field"}, 235},
+	{"Nidorina", [2]string{"Poison", ""}, BaseStats{70, 62, 67, 55, 55, 56}, [2]string{"Poison Point", "Rivalry"}, MEDIUM_SLOW, FEMALE_ONLY, [2]string{"Undiscovered", ""}, 120},
+	{"Nidoqueen", [2]string{"Poison", "Ground"}, BaseStats{90, 82, 87, 75, 85, 76}, [2]string{"Poison Point", "Rivalry"}, MEDIUM_SLOW, FEMALE_ONLY, [2]string{"Undiscovered", ""}, 45},
+	{"Nidoran♂", [2]string{"Poison", ""}, BaseStats{46, 57, 40, 40, 40, 50}, [2]string{"Poison Point", "Rivalry"}, MEDIUM_SLOW, MALE_ONLY, [2]string{"Monster", "Field"}, 235},
+	{"Nidorino", [2]string{"Poison", ""}, BaseStats{61, 72, 57, 55, 55, 65}, [2]string{"Poison Point", "Rivalry"}, MEDIUM_SLOW, MALE_ONLY, [2]string{"Monster", "Field"}, 120},
+	{"Nidoking", [2]string{"Poison", "Ground"}, BaseStats{81, 92, 77, 85, 75, 85}, [2]string{"Poison Point", "Rivalry"}, MEDIUM_SLOW, MALE_ONLY, [2]string{"Monster", "Field"}, 45},
+	{"Clefairy", [2]string{"Normal", ""}, BaseStats{70, 45, 48, 60, 65, 35}, [2]string{"Cute Charm", "Magic Guard"}, FAST, 191, [2]string{"Fairy", ""}, 150},
+	{"Clefable", [2]string{"Normal", ""}, BaseStats{95, 70, 73, 85, 90, 60}, [2]string{"Cute Charm", "Magic Guard"}, FAST, 191, [2]string{"Fairy", ""}, 25},
+	{"Vulpix", [2]string{"Fire", ""}, BaseStats{38, 41, 40, 50, 65, 65}, [2]string{"Flash Fire", ""}, MEDIUM_FAST, 191, [2]string{"Field", ""}, 190},
+	{"Ninetales", [2]string{"Fire", ""}, BaseStats{73, 76, 75, 81, 100, 100}, [2]string{"Flash Fire", ""}, MEDIUM_FAST, 191, [2]string{"Field", ""}, 75},
+	{"Jigglypuff", [2]string{"Normal", ""}, BaseStats{115, 45, 20, 45, 25, 20}, [2]string{"Cute Charm", ""}, FAST, 191, [2]string{"Fairy", ""}, 170},
+	{"Wigglytuff", [2]string{"Normal", ""}, BaseStats{140, 70, 45, 75, 50, 45}, [2]string{"Cute Charm", ""}, FAST, 191, [2]string{"Fairy", ""}, 50},
+	{"Zubat", [2]string{"Poison", "Flying"}, BaseStats{40, 45, 35, 30, 40, 55}, [2]string{"Inner Focus", ""}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 255},
+	{"Golbat", [2]string{"Poison", "Flying"}, BaseStats{75, 80, 70, 65, 75, 90}, [2]string{"Inner Focus", ""}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 90},
+	{"Oddish", [2]string{"Grass", "Poison"}, BaseStats{45, 50, 55, 75, 65, 30}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 255},
+	{"Gloom", [2]string{"Grass", "Poison"}, BaseStats{60, 65, 70, 85, 75, 40}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 120},
+	{"Vileplume", [2]string{"Grass", "Poison"}, BaseStats{75, 80, 85, 100, 90, 50}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 45},
+	{"Paras", [2]string{"Bug", "Grass"}, BaseStats{35, 70, 55, 45, 55, 25}, [2]string{"Effect Spore", "Dry Skin"}, MEDIUM_FAST, 127, [2]string{"Bug", "Grass"}, 190},
+	{"Parasect", [2]string{"Bug", "Grass"}, BaseStats{60, 95, 80, 60, 80, 30}, [2]string{"Effect Spore", "Dry Skin"}, MEDIUM_FAST, 127, [2]string{"Bug", "Grass"}, 75},
+	{"Venonat", [2]string{"Bug", "Poison"}, BaseStats{60, 55, 50, 40, 55, 45}, [2]string{"Compound Eyes", "Tinted Lens"}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 190},
+	{"Venomoth", [2]string{"Bug", "Poison"}, BaseStats{70, 65, 60, 90, 75, 90}, [2]string{"Shield Dust", "Tinted Lens"}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 75},
+	{"Diglett", [2]string{"Ground", ""}, BaseStats{10, 55, 25, 35, 45, 95}, [2]string{"Sand Veil", "Arena Trap"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Dugtrio", [2]string{"Ground", ""}, BaseStats{35, 80, 50, 50, 70, 120}, [2]string{"Sand Veil", "Arena Trap"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 50},
+	{"Meowth", [2]string{"Normal", ""}, BaseStats{40, 45, 35, 40, 40, 90}, [2]string{"Pickup", "Technician"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Persian", [2]string{"Normal", ""}, BaseStats{65, 70, 60, 65, 65, 115}, [2]string{"Limber", "Technician"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 90},
+	{"Psyduck", [2]string{"Water", ""}, BaseStats{50, 52, 48, 65, 50, 55}, [2]string{"Damp", "Cloud Nine"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 190},
+	{"Golduck", [2]string{"Water", ""}, BaseStats{80, 82, 78, 95, 80, 85}, [2]string{"Damp", "Cloud Nine"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 75},
+	{"Mankey", [2]string{"Fighting", ""}, BaseStats{40, 80, 35, 35, 45, 70}, [2]string{"Vital Spirit", "Anger Point"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 190},
+	{"Primeape", [2]string{"Fighting", ""}, BaseStats{65, 105, 60, 60, 70, 95}, [2]string{"Vital Spirit", "Anger Point"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 75},
+	{"Growlithe", [2]string{"Fire", ""}, BaseStats{55, 70, 45, 70, 50, 60}, [2]string{"Intimidate", "Flash Fire"}, SLOW, 63, [2]string{"Field", ""}, 190},
+	{"Arcanine", [2]string{"Fire", ""}, BaseStats{90, 110, 80, 100, 80, 95}, [2]string{"Intimidate", "Flash Fire"}, SLOW, 63, [2]string{"Field", ""}, 75},
+	{"Poliwag", [2]string{"Water", ""}, BaseStats{40, 50, 40, 40, 40, 90}, [2]string{"Water Absorb", "Damp"}, MEDIUM_SLOW, 127, [2]string{"Water 1", ""}, 255},
+	{"Poliwhirl", [2]string{"Water", ""}, BaseStats{65, 65, 65, 50, 50, 90}, [2]string{"Water Absorb", "Damp"}, MEDIUM_SLOW, 127, [2]string{"Water 1", ""}, 120},
+	{"Poliwrath", [2]string{"Water", "Fighting"}, BaseStats{90, 85, 95, 70, 90, 70}, [2]string{"Water Absorb", "Damp"}, MEDIUM_SLOW, 127, [2]string{"Water 1", ""}, 45},
+	{"Abra", [2]string{"Psychic", ""}, BaseStats{25, 20, 15, 105, 55, 90}, [2]string{"Synchronize", "Inner Focus"}, MEDIUM_SLOW, 63, [2]string{"Human-Like", ""}, 200},
+	{"Kadabra", [2]string{"Psychic", ""}, BaseStats{40, 35, 30, 120, 70, 105}, [2]string{"Synchronize", "Inner Focus"}, MEDIUM_SLOW, 63, [2]string{"Human-Like", ""}, 100},
+	{"Alakazam", [2]string{"Psychic", ""}, BaseStats{55, 50, 45, 135, 85, 120}, [2]string{"Synchronize", "Inner Focus"}, MEDIUM_SLOW, 63, [2]string{"Human-Like", ""}, 50},
+	{"Machop", [2]string{"Fighting", ""}, BaseStats{70, 80, 50, 35, 35, 35}, [2]string{"Guts", "No Guard"}, MEDIUM_SLOW, 63, [2]string{"Human-Like", ""}, 180},
+	{"Machoke", [2]string{"Fighting", ""}, BaseStats{80, 100, 70, 50, 60, 45}, [2]string{"Guts", "No Guard"}, MEDIUM_SLOW, 63, [2]string{"Human-Like", ""}, 90},
+	{"Machamp", [2]string{"Fighting", ""}, BaseStats{90, 130, 80, 65, 85, 55}, [2]string{"Guts", "No Guard"}, MEDIUM_SLOW, 63, [2]string{"Human-Like", ""}, 45},
+	{"Bellsprout", [2]string{"Grass", "Poison"}, BaseStats{50, 75, 35, 70, 30, 40}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 255},
+	{"Weepinbell", [2]string{"Grass", "Poison"}, BaseStats{65, 90, 50, 85, 45, 55}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 120},
+	{"Victreebel", [2]string{"Grass", "Poison"}, BaseStats{80, 105, 65, 100, 60, 70}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 45},
+	{"Tentacool", [2]string{"Water", "Poison"}, BaseStats{40, 40, 35, 50, 100, 70}, [2]string{"Clear Body", "Liquid Ooze"}, SLOW, 127, [2]string{"Water 3", ""}, 190},
+	{"Tentacruel", [2]string{"Water", "Poison"}, BaseStats{80, 70, 65, 80, 120, 100}, [2]string{"Clear Body", "Liquid Ooze"}, SLOW, 127, [2]string{"Water 3", ""}, 60},
+	{"Geodude", [2]string{"Rock", "Ground"}, BaseStats{40, 80, 100, 30, 30, 20}, [2]string{"Rock Head", "Sturdy"}, MEDIUM_SLOW, 127, [2]string{"Mineral", ""}, 255},
+	{"Graveler", [2]string{"Rock", "Ground"}, BaseStats{55, 95, 115, 45, 45, 35}, [2]string{"Rock Head", "Sturdy"}, MEDIUM_SLOW, 127, [2]string{"Mineral", ""}, 120},
+	{"Golem", [2]string{"Rock", "Ground"}, BaseStats{80, 110, 130, 55, 65, 45}, [2]string{"Rock Head", "Sturdy"}, MEDIUM_SLOW, 127, [2]string{"Mineral", ""}, 45},
+	{"Ponyta", [2]string{"Fire", ""}, BaseStats{50, 85, 55, 65, 65, 90}, [2]string{"Run Away", "Flash Fire"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 190},
+	{"Rapidash", [2]string{"Fire", ""}, BaseStats{65, 100, 70, 80, 80, 105}, [2]string{"Run Away", "Flash Fire"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 60},
+	{"Slowpoke", [2]string{"Water", "Psychic"}, BaseStats{90, 65, 65, 40, 40, 15}, [2]string{"Oblivious", "Own Tempo"}, MEDIUM_FAST, 127, [2]string{"Monster", "Water 1"}, 190},
+	{"Slowbro", [2]string{"Water", "Psychic"}, BaseStats{95, 75, 110, 100, 80, 30}, [2]string{"Oblivious", "Own Tempo"}, MEDIUM_FAST, 127, [2]string{"Monster", "Water 1"}, 75},
+	{"Magnemite", [2]string{"Electric", "Steel"}, BaseStats{25, 35, 70, 95, 55, 45}, [2]string{"Magnet Pull", "Sturdy"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 190},
+	{"Magneton", [2]string{"Electric", "Steel"}, BaseStats{50, 60, 95, 120, 70, 70}, [2]string{"Magnet Pull", "Sturdy"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 60},
+	{"Farfetch'd", [2]string{"Normal", "Flying"}, BaseStats{52, 65, 55, 58, 62, 60}, [2]string{"Keen Eye", "Inner Focus"}, MEDIUM_FAST, 127, [2]string{"Flying", "Field"}, 45},
+	{"Doduo", [2]string{"Normal", "Flying"}, BaseStats{35, 85, 45, 35, 35, 75}, [2]string{"Run Away", "Early Bird"}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 190},
+	{"Dodrio", [2]string{"Normal", "Flying"}, BaseStats{60, 110, 70, 60, 60, 100}, [2]string{"Run Away", "Early Bird"}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 45},
+	{"Seel", [2]string{"Water", ""}, BaseStats{65, 45, 55, 45, 70, 45}, [2]string{"Thick Fat", "Hydration"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 190},
+	{"Dewgong", [2]string{"Water", "Ice"}, BaseStats{90, 70, 80, 70, 95, 70}, [2]string{"Thick Fat", "Hydration"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 75},
+	{"Grimer", [2]string{"Poison", ""}, BaseStats{80, 80, 50, 40, 50, 25}, [2]string{"Stench", "Sticky Hold"}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 190},
+	{"Muk", [2]string{"Poison", ""}, BaseStats{105, 105, 75, 65, 100, 50}, [2]string{"Stench", "Sticky Hold"}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 75},
+	{"Shellder", [2]string{"Water", ""}, BaseStats{30, 65, 100, 45, 25, 40}, [2]string{"Shell Armor", "Skill Link"}, SLOW, 127, [2]string{"Water 3", ""}, 190},
+	{"Cloyster", [2]string{"Water", "Ice"}, BaseStats{50, 95, 180, 85, 45, 70}, [2]string{"Shell Armor", "Skill Link"}, SLOW, 127, [2]string{"Water 3", ""}, 60},
+	{"Gastly", [2]string{"Ghost", "Poison"}, BaseStats{30, 35, 30, 100, 35, 80}, [2]string{"Levitate", ""}, MEDIUM_SLOW, 127, [2]string{"Amorphous", ""}, 190},
+	{"Haunter", [2]string{"Ghost", "Poison"}, BaseStats{45, 50, 45, 115, 55, 95}, [2]string{"Levitate", ""}, MEDIUM_SLOW, 127, [2]string{"Amorphous", ""}, 90},
+	{"Gengar", [2]string{"Ghost", "Poison"}, BaseStats{60, 65, 60, 130, 75, 110}, [2]string{"Levitate", ""}, MEDIUM_SLOW, 127, [2]string{"Amorphous", ""}, 45},
+	{"Onix", [2]string{"Rock", "Ground"}, BaseStats{35, 45, 160, 30, 45, 70}, [2]string{"Rock Head", "Sturdy"}, MEDIUM_FAST, 127, [2]string{"Mineral", ""}, 45},
+	{"Drowzee", [2]string{"Psychic", ""}, BaseStats{60, 48, 45, 43, 90, 42}, [2]string{"Insomnia", "Forewarn"}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 190},
+	{"Hypno", [2]string{"Psychic", ""}, BaseStats{85, 73, 70, 73, 115, 67}, [2]string{"Insomnia", "Forewarn"}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 75},
+	{"Krabby", [2]string{"Water", ""}, BaseStats{30, 105, 90, 25, 25, 50}, [2]string{"Hyper Cutter", "Shell Armor"}, MEDIUM_FAST, 127, [2]string{"Water 3", ""}, 225},
+	{"Kingler", [2]string{"Water", ""}, BaseStats{55, 130, 115, 50, 50, 75}, [2]string{"Hyper Cutter", "Shell Armor"}, MEDIUM_FAST, 127, [2]string{"Water 3", ""}, 60},
+	{"Voltorb", [2]string{"Electric", ""}, BaseStats{40, 30, 50, 55, 55, 100}, [2]string{"Soundproof", "Static"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 190},
+	{"Electrode", [2]string{"Electric", ""}, BaseStats{60, 50, 70, 80, 80, 140}, [2]string{"Soundproof", "Static"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 60},
+	{"Exeggcute", [2]string{"Grass", "Psychic"}, BaseStats{60, 40, 80, 60, 45, 40}, [2]string{"Chlorophyll", ""}, SLOW, 127, [2]string{"Grass", ""}, 90},
+	{"Exeggutor", [2]string{"Grass", "Psychic"}, BaseStats{95, 95, 85, 125, 65, 55}, [2]string{"Chlorophyll", ""}, SLOW, 127, [2]string{"Grass", ""}, 45},
+	{"Cubone", [2]string{"Ground", ""}, BaseStats{50, 50, 95, 40, 50, 35}, [2]string{"Rock Head", "Lightning Rod"}, MEDIUM_FAST, 127, [2]string{"Monster", ""}, 190},
+	{"Marowak", [2]string{"Ground", ""}, BaseStats{60, 80, 110, 50, 80, 45}, [2]string{"Rock Head", "Lightning Rod"}, MEDIUM_FAST, 127, [2]string{"Monster", ""}, 75},
+	{"Hitmonlee", [2]string{"Fighting", ""}, BaseStats{50, 120, 53, 35, 110, 87}, [2]string{"Limber", "Reckless"}, MEDIUM_FAST, MALE_ONLY, [2]string{"Human-Like", ""}, 45},
+	{"Hitmonchan", [2]string{"Fighting", ""}, BaseStats{50, 105, 79, 35, 110, 76}, [2]string{"Keen Eye", "Iron Fist"}, MEDIUM_FAST, MALE_ONLY, [2]string{"Human-Like", ""}, 45},
+	{"Lickitung", [2]string{"Normal", ""}, BaseStats{90, 55, 75, 60, 75, 30}, [2]string{"Own Tempo", "Oblivious"}, MEDIUM_FAST, 127, [2]string{"Monster", ""}, 45},
+	{"Koffing", [2]string{"Poison", ""}, BaseStats{40, 65, 95, 60, 45, 35}, [2]string{"Levitate", ""}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 190},
+	{"Weezing", [2]string{"Poison", ""}, BaseStats{65, 90, 120, 85, 70, 60}, [2]string{"Levitate", ""}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 60},
+	{"Rhyhorn", [2]string{"Ground", "Rock"}, BaseStats{80, 85, 95, 30, 30, 25}, [2]string{"Lightning Rod", "Rock Head"}, SLOW, 127, [2]string{"Monster", "Field"}, 120},
+	{"Rhydon", [2]string{"Ground", "Rock"}, BaseStats{105, 130, 120, 45, 45, 40}, [2]string{"Lightning Rod", "Rock Head"}, SLOW, 127, [2]string{"Monster", "Field"}, 60},
+	{"Chansey", [2]string{"Normal", ""}, BaseStats{250, 5, 5, 35, 105, 50}, [2]string{"Natural Cure", "Serene Grace"}, FAST, FEMALE_ONLY, [2]string{"Fairy", ""}, 30},
+	{"Tangela", [2]string{"Grass", ""}, BaseStats{65, 55, 115, 100, 40, 60}, [2]string{"Chlorophyll", "Leaf Guard"}, MEDIUM_FAST, 127, [2]string{"Grass", ""}, 45},
+	{"Kangaskhan", [2]string{"Normal", ""}, BaseStats{105, 95, 80, 40, 80, 90}, [2]string{"Early Bird", "Scrappy"}, MEDIUM_FAST, FEMALE_ONLY, [2]string{"Monster", ""}, 45},
+	{"Horsea", [2]string{"Water", ""}, BaseStats{30, 40, 70, 70, 25, 60}, [2]string{"Swift Swim", "Sniper"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Dragon"}, 225},
+	{"Seadra", [2]string{"Water", ""}, BaseStats{55, 65, 95, 95, 45, 85}, [2]string{"Poison Point", "Sniper"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Dragon"}, 75},
+	{"Goldeen", [2]string{"Water", ""}, BaseStats{45, 67, 60, 35, 50, 63}, [2]string{"Swift Swim", "Water Veil"}, MEDIUM_FAST, 127, [2]string{"Water 2", ""}, 225},
+	{"Seaking", [2]string{"Water", ""}, BaseStats{80, 92, 65, 65, 80, 68}, [2]string{"Swift Swim", "Water Veil"}, MEDIUM_FAST, 127, [2]string{"Water 2", ""}, 60},
+	{"Staryu", [2]string{"Water", ""}, BaseStats{30, 45, 55, 70, 55, 85}, [2]string{"Illuminate", "Natural Cure"}, SLOW, GENDERLESS, [2]string{"Water 3", ""}, 225},
+	{"Starmie", [2]string{"Water", "Psychic"}, BaseStats{60, 75, 85, 100, 85, 115}, [2]string{"Illuminate", "Natural Cure"}, SLOW, GENDERLESS, [2]string{"Water 3", ""}, 60},
+	{"Mr. Mime", [2]string{"Psychic", ""}, BaseStats{40, 45, 65, 100, 120, 90}, [2]string{"Soundproof", "Filter"}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 45},
+	{"Scyther", [2]string{"Bug", "Flying"}, BaseStats{70, 110, 80, 55, 80, 105}, [2]string{"Swarm", "Technician"}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 45},
+	{"Jynx", [2]string{"Ice", "Psychic"}, BaseStats{65, 50, 35, 115, 95, 95}, [2]string{"Oblivious", "Forewarn"}, MEDIUM_FAST, FEMALE_ONLY, [2]string{"Human-Like", ""}, 45},
+	{"Electabuzz", [2]string{"Electric", ""}, BaseStats{65, 83, 57, 95, 85, 105}, [2]string{"Static", ""}, MEDIUM_FAST, 63, [2]string{"Human-Like", ""}, 45},
+	{"Magmar", [2]string{"Fire", ""}, BaseStats{65, 95, 57, 100, 85, 93}, [2]string{"Flame Body", ""}, MEDIUM_FAST, 63, [2]string{"Human-Like", ""}, 45},
+	{"Pinsir", [2]string{"Bug", ""}, BaseStats{65, 125, 100, 55, 70, 85}, [2]string{"Hyper Cutter", "Mold Breaker"}, SLOW, 127, [2]string{"Bug", ""}, 45},
+	{"Tauros", [2]string{"Normal", ""}, BaseStats{75, 100, 95, 40, 70, 110}, [2]string{"Intimidate", "Anger Point"}, SLOW, MALE_ONLY, [2]string{"Field", ""}, 45},
+	{"Magikarp", [2]string{"Water", ""}, BaseStats{20, 10, 55, 15, 20, 80}, [2]string{"Swift Swim", ""}, SLOW, 127, [2]string{"Water 2", "Dragon"}, 255},
+	{"Gyarados", [2]string{"Water", "Flying"}, BaseStats{95, 125, 79, 60, 100, 81}, [2]string{"Intimidate", ""}, SLOW, 127, [2]string{"Water 2", "Dragon"}, 45},
+	{"Lapras", [2]string{"Water", "Ice"}, BaseStats{130, 85, 80, 85, 95, 60}, [2]string{"Water Absorb", "Shell Armor"}, SLOW, 127, [2]string{"Monster", "Water 1"}, 45},
+	{"Ditto", [2]string{"Normal", ""}, BaseStats{48, 48, 48, 48, 48, 48}, [2]string{"Limber", ""}, MEDIUM_FAST, GENDERLESS, [2]string{"Ditto", ""}, 35},
+	{"Eevee", [2]string{"Normal", ""}, BaseStats{55, 55, 50, 45, 65, 55}, [2]string{"Run Away", "Adaptability"}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Vaporeon", [2]string{"Water", ""}, BaseStats{130, 65, 60, 110, 95, 65}, [2]string{"Water Absorb", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Jolteon", [2]string{"Electric", ""}, BaseStats{65, 65, 60, 110, 95, 130}, [2]string{"Volt Absorb", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Flareon", [2]string{"Fire", ""}, BaseStats{65, 130, 60, 95, 110, 65}, [2]string{"Flash Fire", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Porygon", [2]string{"Normal", ""}, BaseStats{65, 60, 70, 85, 75, 40}, [2]string{"Trace", "Download"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 45},
+	{"Omanyte", [2]string{"Rock", "Water"}, BaseStats{35, 40, 100, 90, 55, 35}, [2]string{"Swift Swim", "Shell Armor"}, MEDIUM_FAST, 31, [2]string{"Water 1", "Water 3"}, 45},
+	{"Omastar", [2]string{"Rock", "Water"}, BaseStats{70, 60, 125, 115, 70, 55}, [2]string{"Swift Swim", "Shell Armor"}, MEDIUM_FAST, 31, [2]string{"Water 1", "Water 3"}, 45},
+	{"Kabuto", [2]string{"Rock", "Water"}, BaseStats{30, 80, 90, 55, 45, 55}, [2]string{"Swift Swim", "Battle Armor"}, MEDIUM_FAST, 31, [2]string{"Water 1", "Water 3"}, 45},
+	{"Kabutops", [2]string{"Rock", "Water"}, BaseStats{60, 115, 105, 65, 70, 80}, [2]string{"Swift Swim", "Battle Armor"}, MEDIUM_FAST, 31, [2]string{"Water 1", "Water 3"}, 45},
+	{"Aerodactyl", [2]string{"Rock", "Flying"}, BaseStats{80, 105, 65, 60, 75, 130}, [2]string{"Rock Head", "Pressure"}, SLOW, 31, [2]string{"Flying", ""}, 45},
+	{"Snorlax", [2]string{"Normal", ""}, BaseStats{160, 110, 65, 65, 110, 30}, [2]string{"Immunity", "Thick Fat"}, SLOW, 31, [2]string{"Monster", ""}, 25},
+	{"Articuno", [2]string{"Ice", "Flying"}, BaseStats{90, 85, 100, 95, 125, 85}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Zapdos", [2]string{"Electric", "Flying"}, BaseStats{90, 90, 85, 125, 90, 100}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Moltres", [2]string{"Fire", "Flying"}, BaseStats{90, 100, 90, 125, 85, 90}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Dratini", [2]string{"Dragon", ""}, BaseStats{41, 64, 45, 50, 50, 50}, [2]string{"Shed Skin", ""}, SLOW, 127, [2]string{"Water 1", "Dragon"}, 45},
+	{"Dragonair", [2]string{"Dragon", ""}, BaseStats{61, 84, 65, 70, 70, 70}, [2]string{"Shed Skin", ""}, SLOW, 127, [2]string{"Water 1", "Dragon"}, 45},
+	{"Dragonite", [2]string{"Dragon", "Flying"}, BaseStats{91, 134, 95, 100, 100, 80}, [2]string{"Inner Focus", ""}, SLOW, 127, [2]string{"Water 1", "Dragon"}, 45},
+	{"Mewtwo", [2]string{"Psychic", ""}, BaseStats{106, 110, 90, 154, 90, 130}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Mew", [2]string{"Psychic", ""}, BaseStats{100, 100, 100, 100, 100, 100}, [2]string{"Synchronize", ""}, MEDIUM_SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 45},
+	{"Chikorita", [2]string{"Grass", ""}, BaseStats{45, 49, 65, 49, 65, 45}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Bayleef", [2]string{"Grass", ""}, BaseStats{60, 62, 80, 63, 80, 60}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Meganium", [2]string{"Grass", ""}, BaseStats{80, 82, 100, 83, 100, 80}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Cyndaquil", [2]string{"Fire", ""}, BaseStats{39, 52, 43, 60, 50, 65}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", ""}, 45},
+	{"Quilava", [2]string{"Fire", ""}, BaseStats{58, 64, 58, 80, 65, 80}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", ""}, 45},
+	{"Typhlosion", [2]string{"Fire", ""}, BaseStats{78, 84, 78, 109, 85, 100}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", ""}, 45},
+	{"Totodile", [2]string{"Water", ""}, BaseStats{50, 65, 64, 44, 48, 43}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Croconaw", [2]string{"Water", ""}, BaseStats{65, 80, 80, 59, 63, 58}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Feraligatr", [2]string{"Water", ""}, BaseStats{85, 105, 100, 79, 83, 78}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Sentret", [2]string{"Normal", ""}, BaseStats{35, 46, 34, 35, 45, 20}, [2]string{"Run Away", "Keen Eye"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Furret", [2]string{"Normal", ""}, BaseStats{85, 76, 64, 45, 55, 90}, [2]string{"Run Away", "Keen Eye"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 90},
+	{"Hoothoot", [2]string{"Normal", "Flying"}, BaseStats{60, 30, 30, 36, 56, 50}, [2]string{"Insomnia", "Keen Eye"}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 255},
+	{"Noctowl", [2]string{"Normal", "Flying"}, BaseStats{100, 50, 50, 76, 96, 70}, [2]string{"Insomnia", "Keen Eye"}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 90},
+	{"Ledyba", [2]string{"Bug", "Flying"}, BaseStats{40, 20, 30, 40, 80, 55}, [2]string{"Swarm", "Early Bird"}, FAST, 127, [2]string{"Bug", ""}, 255},
+	{"Ledian", [2]string{"Bug", "Flying"}, BaseStats{55, 35, 50, 55, 110, 85}, [2]string{"Swarm", "Early Bird"}, FAST, 127, [2]string{"Bug", ""}, 90},
+	{"Spinarak", [2]string{"Bug", "Poison"}, BaseStats{40, 60, 40, 40, 40, 30}, [2]string{"Swarm", "Insomnia"}, FAST, 127, [2]string{"Bug", ""}, 255},
+	{"Ariados", [2]string{"Bug", "Poison"}, BaseStats{70, 90, 70, 60, 60, 40}, [2]string{"Swarm", "Insomnia"}, FAST, 127, [2]string{"Bug", ""}, 90},
+	{"Crobat", [2]string{"Poison", "Flying"}, BaseStats{85, 90, 80, 70, 80, 130}, [2]string{"Inner Focus", ""}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 90},
+	{"Chinchou", [2]string{"Water", "Electric"}, BaseStats{75, 38, 38, 56, 56, 67}, [2]string{"Volt Absorb", "Illuminate"}, SLOW, 127, [2]string{"Water 2", ""}, 190},
+	{"Lanturn", [2]string{"Water", "Electric"}, BaseStats{125, 58, 58, 76, 76, 67}, [2]string{"Volt Absorb", "Illuminate"}, SLOW, 127, [2]string{"Water 2", ""}, 75},
+	{"Pichu", [2]string{"Electric", ""}, BaseStats{20, 40, 15, 35, 35, 60}, [2]string{"Static", ""}, MEDIUM_FAST, 127, [2]string{"Undiscovered", ""}, 190},
+	{"Cleffa", [2]string{"Normal", ""}, BaseStats{50, 25, 28, 45, 55, 15}, [2]string{"Cute Charm", "Magic Guard"}, FAST, 191, [2]string{"Undiscovered", ""}, 150},
+	{"Igglybuff", [2]string{"Normal", ""}, BaseStats{90, 30, 15, 40, 20, 15}, [2]string{"Cute Charm", ""}, FAST, 191, [2]string{"Undiscovered", ""}, 170},
+	{"Togepi", [2]string{"Normal", ""}, BaseStats{35, 20, 65, 40, 65, 20}, [2]string{"Hustle", "Serene Grace"}, FAST, 31, [2]string{"Undiscovered", ""}, 190},
+	{"Togetic", [2]string{"Normal", "Flying"}, BaseStats{55, 40, 85, 80, 105, 40}, [2]string{"Hustle", "Serene Grace"}, FAST, 31, [2]string{"Flying", "Fairy"}, 75},
+	{"Natu", [2]string{"Psychic", "Flying"}, BaseStats{40, 50, 45, 70, 45, 70}, [2]string{"Synchronize", "Early Bird"}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 190},
+	{"Xatu", [2]string{"Psychic", "Flying"}, BaseStats{65, 75, 70, 95, 70, 95}, [2]string{"Synchronize", "Early Bird"}, MEDIUM_FAST, 127, [2]string{"Flying", ""}, 75},
+	{"Mareep", [2]string{"Electric", ""}, BaseStats{55, 40, 40, 65, 45, 35}, [2]string{"Static", ""}, MEDIUM_SLOW, 127, [2]string{"Monster", "Field"}, 235},
+	{"Flaaffy", [2]string{"Electric", ""}, BaseStats{70, 55, 55, 80, 60, 45}, [2]string{"Static", ""}, MEDIUM_SLOW, 127, [2]string{"Monster", "Field"}, 120},
+	{"Ampharos", [2]string{"Electric", ""}, BaseStats{90, 75, 75, 115, 90, 55}, [2]string{"Static", ""}, MEDIUM_SLOW, 127, [2]string{"Monster", "Field"}, 45},
+	{"Bellossom", [2]string{"Grass", ""}, BaseStats{75, 80, 85, 90, 100, 50}, [2]string{"Chlorophyll", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 45},
+	{"Marill", [2]string{"Water", ""}, BaseStats{70, 20, 50, 20, 50, 40}, [2]string{"Thick Fat", "Huge Power"}, FAST, 127, [2]string{"Water 1", "Fairy"}, 190},
+	{"Azumarill", [2]string{"Water", ""}, BaseStats{100, 50, 80, 50, 80, 50}, [2]string{"Thick Fat", "Huge Power"}, FAST, 127, [2]string{"Water 1", "Fairy"}, 75},
+	{"Sudowoodo", [2]string{"Rock", ""}, BaseStats{70, 100, 115, 30, 65, 30}, [2]string{"Sturdy", "Rock Head"}, MEDIUM_FAST, 127, [2]string{"Mineral", ""}, 65},
+	{"Politoed", [2]string{"Water", ""}, BaseStats{90, 75, 75, 90, 100, 70}, [2]string{"Water Absorb", "Damp"}, MEDIUM_SLOW, 127, [2]string{"Water 1", ""}, 45},
+	{"Hoppip", [2]string{"Grass", "Flying"}, BaseStats{35, 35, 40, 35, 55, 50}, [2]string{"Chlorophyll", "Leaf Guard"}, MEDIUM_SLOW, 127, [2]string{"Fairy", "Grass"}, 255},
+	{"Skiploom", [2]string{"Grass", "Flying"}, BaseStats{55, 45, 50, 45, 65, 80}, [2]string{"Chlorophyll", "Leaf Guard"}, MEDIUM_SLOW, 127, [2]string{"Fairy", "Grass"}, 120},
+	{"Jumpluff", [2]string{"Grass", "Flying"}, BaseStats{75, 55, 70, 55, 85, 110}, [2]string{"Chlorophyll", "Leaf Guard"}, MEDIUM_SLOW, 127, [2]string{"Fairy", "Grass"}, 45},
+	{"Aipom", [2]string{"Normal", ""}, BaseStats{55, 70, 55, 40, 55, 85}, [2]string{"Run Away", "Pickup"}, FAST, 127, [2]string{"Field", ""}, 45},
+	{"Sunkern", [2]string{"Grass", ""}, BaseStats{30, 30, 30, 30, 30, 30}, [2]string{"Chlorophyll", "Solar Power"}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 235},
+	{"Sunflora", [2]string{"Grass", ""}, BaseStats{75, 75, 55, 105, 85, 30}, [2]string{"Chlorophyll", "Solar Power"}, MEDIUM_SLOW, 127, [2]string{"Grass", ""}, 120},
+	{"Yanma", [2]string{"Bug", "Flying"}, BaseStats{65, 65, 45, 75, 45, 95}, [2]string{"Speed Boost", "Compound Eyes"}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 75},
+	{"Wooper", [2]string{"Water", "Ground"}, BaseStats{55, 45, 45, 25, 25, 15}, [2]string{"Damp", "Water Absorb"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 255},
+	{"Quagsire", [2]string{"Water", "Ground"}, BaseStats{95, 85, 85, 65, 65, 35}, [2]string{"Damp", "Water Absorb"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 90},
+	{"Espeon", [2]string{"Psychic", ""}, BaseStats{65, 65, 60, 130, 95, 110}, [2]string{"Synchronize", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Umbreon", [2]string{"Dark", ""}, BaseStats{95, 65, 110, 60, 130, 65}, [2]string{"Synchronize", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Murkrow", [2]string{"Dark", "Flying"}, BaseStats{60, 85, 42, 85, 42, 91}, [2]string{"Insomnia", "Super Luck"}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 30},
+	{"Slowking", [2]string{"Water", "Psychic"}, BaseStats{95, 75, 80, 100, 110, 30}, [2]string{"Oblivious", "Own Tempo"}, MEDIUM_FAST, 127, [2]string{"Monster", "Water 1"}, 70},
+	{"Misdreavus", [2]string{"Ghost", ""}, BaseStats{60, 60, 60, 85, 85, 85}, [2]string{"Levitate", ""}, FAST, 127, [2]string{"Amorphous", ""}, 45},
+	{"Unown", [2]string{"Psychic", ""}, BaseStats{48, 72, 48, 72, 48, 48}, [2]string{"Levitate", ""}, MEDIUM_FAST, GENDERLESS, [2]string{"Undiscovered", ""}, 225},
+	{"Wobbuffet", [2]string{"Psychic", ""}, BaseStats{190, 33, 58, 33, 58, 33}, [2]string{"Shadow Tag", ""}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 45},
+	{"Girafarig", [2]string{"Normal", "Psychic"}, BaseStats{70, 80, 65, 90, 65, 85}, [2]string{"Inner Focus", "Early Bird"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 60},
+	{"Pineco", [2]string{"Bug", ""}, BaseStats{50, 65, 90, 35, 35, 15}, [2]string{"Sturdy", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 190},
+	{"Forretress", [2]string{"Bug", "Steel"}, BaseStats{75, 90, 140, 60, 60, 40}, [2]string{"Sturdy", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 75},
+	{"Dunsparce", [2]string{"Normal", ""}, BaseStats{100, 70, 70, 65, 65, 45}, [2]string{"Serene Grace", "Run Away"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 190},
+	{"Gligar", [2]string{"Ground", "Flying"}, BaseStats{65, 75, 105, 35, 65, 85}, [2]string{"Hyper Cutter", "Sand Veil"}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 60},
+	{"Steelix", [2]string{"Steel", "Ground"}, BaseStats{75, 85, 200, 55, 65, 30}, [2]string{"Rock Head", "Sturdy"}, MEDIUM_FAST, 127, [2]string{"Mineral", ""}, 25},
+	{"Snubbull", [2]string{"Normal", ""}, BaseStats{60, 80, 50, 40, 40, 30}, [2]string{"Intimidate", "Run Away"}, FAST, 191, [2]string{"Field", "Fairy"}, 190},
+	{"Granbull", [2]string{"Normal", ""}, BaseStats{90, 120, 75, 60, 60, 45}, [2]string{"Intimidate", "Quick Feet"}, FAST, 191, [2]string{"Field", "Fairy"}, 75},
+	{"Qwilfish", [2]string{"Water", "Poison"}, BaseStats{65, 95, 75, 55, 55, 85}, [2]string{"Poison Point", "Swift Swim"}, MEDIUM_FAST, 127, [2]string{"Water 2", ""}, 45},
+	{"Scizor", [2]string{"Bug", "Steel"}, BaseStats{70, 130, 100, 55, 80, 65}, [2]string{"Swarm", "Technician"}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 25},
+	{"Shuckle", [2]string{"Bug", "Rock"}, BaseStats{20, 10, 230, 10, 230, 5}, [2]string{"Sturdy", "Gluttony"}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 190},
+	{"Heracross", [2]string{"Bug", "Fighting"}, BaseStats{80, 125, 75, 40, 95, 85}, [2]string{"Swarm", "Guts"}, SLOW, 127, [2]string{"Bug", ""}, 45},
+	{"Sneasel", [2]string{"Dark", "Ice"}, BaseStats{55, 95, 55, 35, 75, 115}, [2]string{"Inner Focus", "Keen Eye"}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 60},
+	{"Teddiursa", [2]string{"Normal", ""}, BaseStats{60, 80, 50, 50, 50, 40}, [2]string{"Pickup", "Quick Feet"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 120},
+	{"Ursaring", [2]string{"Normal", ""}, BaseStats{90, 130, 75, 75, 75, 55}, [2]string{"Guts", "Quick Feet"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 60},
+	{"Slugma", [2]string{"Fire", ""}, BaseStats{40, 40, 40, 70, 40, 20}, [2]string{"Magma Armor", "Flame Body"}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 190},
+	{"Magcargo", [2]string{"Fire", "Rock"}, BaseStats{50, 50, 120, 80, 80, 30}, [2]string{"Magma Armor", "Flame Body"}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 75},
+	{"Swinub", [2]string{"Ice", "Ground"}, BaseStats{50, 50, 40, 30, 30, 50}, [2]string{"Oblivious", "Snow Cloak"}, SLOW, 127, [2]string{"Field", ""}, 225},
+	{"Piloswine", [2]string{"Ice", "Ground"}, BaseStats{100, 100, 80, 60, 60, 50}, [2]string{"Oblivious", "Snow Cloak"}, SLOW, 127, [2]string{"Field", ""}, 75},
+	{"Corsola", [2]string{"Water", "Rock"}, BaseStats{55, 55, 85, 65, 85, 35}, [2]string{"Hustle", "Natural Cure"}, FAST, 191, [2]string{"Water 1", "Water 3"}, 60},
+	{"Remoraid", [2]string{"Water", ""}, BaseStats{35, 65, 35, 65, 35, 65}, [2]string{"Hustle", "Sniper"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Water 2"}, 190},
+	{"Octillery", [2]string{"Water", ""}, BaseStats{75, 105, 75, 105, 75, 45}, [2]string{"Suction Cups", "Sniper"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Water 2"}, 75},
+	{"Delibird", [2]string{"Ice", "Flying"}, BaseStats{45, 55, 45, 65, 45, 75}, [2]string{"Vital Spirit", "Hustle"}, FAST, 127, [2]string{"Water 1", "Field"}, 45},
+	{"Mantine", [2]string{"Water", "Flying"}, BaseStats{65, 40, 70, 80, 140, 70}, [2]string{"Swift Swim", "Water Absorb"}, SLOW, 127, [2]string{"Water 1", ""}, 25},
+	{"Skarmory", [2]string{"Steel", "Flying"}, BaseStats{65, 80, 140, 40, 70, 70}, [2]string{"Keen Eye", "Sturdy"}, SLOW, 127, [2]string{"Flying", ""}, 25},
+	{"Houndour", [2]string{"Dark", "Fire"}, BaseStats{45, 60, 30, 80, 50, 65}, [2]string{"Early Bird", "Flash Fire"}, SLOW, 127, [2]string{"Field", ""}, 120},
+	{"Houndoom", [2]string{"Dark", "Fire"}, BaseStats{75, 90, 50, 110, 80, 95}, [2]string{"Early Bird", "Flash Fire"}, SLOW, 127, [2]string{"Field", ""}, 45},
+	{"Kingdra", [2]string{"Water", "Dragon"}, BaseStats{75, 95, 95, 95, 95, 85}, [2]string{"Swift Swim", "Sniper"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Dragon"}, 45},
+	{"Phanpy", [2]string{"Ground", ""}, BaseStats{90, 60, 60, 40, 40, 40}, [2]string{"Pickup", ""}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 120},
+	{"Donphan", [2]string{"Ground", ""}, BaseStats{90, 120, 120, 60, 60, 50}, [2]string{"Sturdy", ""}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 60},
+	{"Porygon2", [2]string{"Normal", ""}, BaseStats{85, 80, 90, 105, 95, 60}, [2]string{"Trace", "Download"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 45},
+	{"Stantler", [2]string{"Normal", ""}, BaseStats{73, 95, 62, 85, 65, 85}, [2]string{"Intimidate", "Frisk"}, SLOW, 127, [2]string{"Field", ""}, 45},
+	{"Smeargle", [2]string{"Normal", ""}, BaseStats{55, 20, 35, 20, 45, 75}, [2]string{"Own Tempo", "Technician"}, FAST, 127, [2]string{"Field", ""}, 45},
+	{"Tyrogue", [2]string{"Fighting", ""}, BaseStats{35, 35, 35, 35, 35, 35}, [2]string{"Guts", "Steadfast"}, MEDIUM_FAST, MALE_ONLY, [2]string{"Undiscovered", ""}, 75},
+	{"Hitmontop", [2]string{"Fighting", ""}, BaseStats{50, 95, 95, 35, 110, 70}, [2]string{"Intimidate", "Technician"}, MEDIUM_FAST, MALE_ONLY, [2]string{"Human-Like", ""}, 45},
+	{"Smoochum", [2]string{"Ice", "Psychic"}, BaseStats{45, 30, 15, 85, 65, 65}, [2]string{"Oblivious", "Forewarn"}, MEDIUM_FAST, FEMALE_ONLY, [2]string{"Undiscovered", ""}, 45},
+	{"Elekid", [2]string{"Electric", ""}, BaseStats{45, 63, 37, 65, 55, 95}, [2]string{"Static", ""}, MEDIUM_FAST, 63, [2]string{"Undiscovered", ""}, 45},
+	{"Magby", [2]string{"Fire", ""}, BaseStats{45, 75, 37, 70, 55, 83}, [2]string{"Flame Body", ""}, MEDIUM_FAST, 63, [2]string{"Undiscovered", ""}, 45},
+	{"Miltank", [2]string{"Normal", ""}, BaseStats{95, 80, 105, 40, 70, 100}, [2]string{"Thick Fat", "Scrappy"}, SLOW, FEMALE_ONLY, [2]string{"Field", ""}, 45},
+	{"Blissey", [2]string{"Normal", ""}, BaseStats{255, 10, 10, 75, 135, 55}, [2]string{"Natural Cure", "Serene Grace"}, FAST, FEMALE_ONLY, [2]string{"Fairy", ""}, 30},
+	{"Raikou", [2]string{"Electric", ""}, BaseStats{90, 85, 75, 115, 100, 115}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Entei", [2]string{"Fire", ""}, BaseStats{115, 115, 85, 90, 75, 100}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Suicune", [2]string{"Water", ""}, BaseStats{100, 75, 115, 90, 115, 85}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Larvitar", [2]string{"Rock", "Ground"}, BaseStats{50, 64, 50, 45, 50, 41}, [2]string{"Guts", ""}, SLOW, 127, [2]string{"Monster", ""}, 45},
+	{"Pupitar", [2]string{"Rock", "Ground"}, BaseStats{70, 84, 70, 65, 70, 51}, [2]string{"Shed Skin", ""}, SLOW, 127, [2]string{"Monster", ""}, 45},
+	{"Tyranitar", [2]string{"Rock", "Dark"}, BaseStats{100, 134, 110, 95, 100, 61}, [2]string{"Sand Stream", ""}, SLOW, 127, [2]string{"Monster", ""}, 45},
+	{"Lugia", [2]string{"Psychic", "Flying"}, BaseStats{106, 90, 130, 90, 154, 110}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Ho-Oh", [2]string{"Fire", "Flying"}, BaseStats{106, 130, 90, 110, 154, 90}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Celebi", [2]string{"Psychic", "Grass"}, BaseStats{100, 100, 100, 100, 100, 100}, [2]string{"Natural Cure", ""}, MEDIUM_SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 45},
+	{"Treecko", [2]string{"Grass", ""}, BaseStats{40, 45, 35, 65, 55, 70}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Dragon"}, 45},
+	{"Grovyle", [2]string{"Grass", ""}, BaseStats{50, 65, 45, 85, 65, 95}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Dragon"}, 45},
+	{"Sceptile", [2]string{"Grass", ""}, BaseStats{70, 85, 65, 105, 85, 120}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Dragon"}, 45},
+	{"Torchic", [2]string{"Fire", ""}, BaseStats{45, 60, 40, 70, 50, 45}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", ""}, 45},
+	{"Combusken", [2]string{"Fire", "Fighting"}, BaseStats{60, 85, 60, 85, 60, 55}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", ""}, 45},
+	{"Blaziken", [2]string{"Fire", "Fighting"}, BaseStats{80, 120, 70, 110, 70, 80}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", ""}, 45},
+	{"Mudkip", [2]string{"Water", ""}, BaseStats{50, 70, 50, 50, 50, 40}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Marshtomp", [2]string{"Water", "Ground"}, BaseStats{70, 85, 70, 60, 70, 50}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Swampert", [2]string{"Water", "Ground"}, BaseStats{100, 110, 90, 85, 90, 60}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Water 1"}, 45},
+	{"Poochyena", [2]string{"Dark", ""}, BaseStats{35, 55, 35, 30, 30, 35}, [2]string{"Run Away", "Quick Feet"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Mightyena", [2]string{"Dark", ""}, BaseStats{70, 90, 70, 60, 60, 70}, [2]string{"Intimidate", "Quick Feet"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 127},
+	{"Zigzagoon", [2]string{"Normal", ""}, BaseStats{38, 30, 41, 30, 41, 60}, [2]string{"Pickup", "Gluttony"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Linoone", [2]string{"Normal", ""}, BaseStats{78, 70, 61, 50, 61, 100}, [2]string{"Pickup", "Gluttony"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 90},
+	{"Wurmple", [2]string{"Bug", ""}, BaseStats{45, 45, 35, 20, 30, 20}, [2]string{"Shield Dust", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 255},
+	{"Silcoon", [2]string{"Bug", ""}, BaseStats{50, 35, 55, 25, 25, 15}, [2]string{"Shed Skin", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 120},
+	{"Beautifly", [2]string{"Bug", "Flying"}, BaseStats{60, 70, 50, 90, 50, 65}, [2]string{"Swarm", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 45},
+	{"Cascoon", [2]string{"Bug", ""}, BaseStats{50, 35, 55, 25, 25, 15}, [2]string{"Shed Skin", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 120},
+	{"Dustox", [2]string{"Bug", "Poison"}, BaseStats{60, 50, 70, 50, 90, 65}, [2]string{"Shield Dust", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 45},
+	{"Lotad", [2]string{"Water", "Grass"}, BaseStats{40, 30, 30, 40, 50, 30}, [2]string{"Swift Swim", "Rain Dish"}, MEDIUM_SLOW, 127, [2]string{"Water 1", "Grass"}, 255},
+	{"Lombre", [2]string{"Water", "Grass"}, BaseStats{60, 50, 50, 60, 70, 50}, [2]string{"Swift Swim", "Rain Dish"}, MEDIUM_SLOW, 127, [2]string{"Water 1", "Grass"}, 120},
+	{"Ludicolo", [2]string{"Water", "Grass"}, BaseStats{80, 70, 70, 90, 100, 70}, [2]string{"Swift Swim", "Rain Dish"}, MEDIUM_SLOW, 127, [2]string{"Water 1", "Grass"}, 45},
+	{"Seedot", [2]string{"Grass", ""}, BaseStats{40, 40, 50, 30, 30, 30}, [2]string{"Chlorophyll", "Early Bird"}, MEDIUM_SLOW, 127, [2]string{"Field", "Grass"}, 255},
+	{"Nuzleaf", [2]string{"Grass", "Dark"}, BaseStats{70, 70, 40, 60, 40, 60}, [2]string{"Chlorophyll", "Early Bird"}, MEDIUM_SLOW, 127, [2]string{"Field", "Grass"}, 120},
+	{"Shiftry", [2]string{"Grass", "Dark"}, BaseStats{90, 100, 60, 90, 60, 80}, [2]string{"Chlorophyll", "Early Bird"}, MEDIUM_SLOW, 127, [2]string{"Field", "Grass"}, 45},
+	{"Taillow", [2]string{"Normal", "Flying"}, BaseStats{40, 55, 30, 30, 30, 85}, [2]string{"Guts", ""}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 200},
+	{"Swellow", [2]string{"Normal", "Flying"}, BaseStats{60, 85, 60, 50, 50, 125}, [2]string{"Guts", ""}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 45},
+	{"Wingull", [2]string{"Water", "Flying"}, BaseStats{40, 30, 30, 55, 30, 85}, [2]string{"Keen Eye", ""}, MEDIUM_FAST, 127, [2]string{"Water 1", "Flying"}, 190},
+	{"Pelipper", [2]string{"Water", "Flying"}, BaseStats{60, 50, 100, 85, 70, 65}, [2]string{"Keen Eye", ""}, MEDIUM_FAST, 127, [2]string{"Water 1", "Flying"}, 45},
+	{"Ralts", [2]string{"Psychic", ""}, BaseStats{28, 25, 25, 45, 35, 40}, [2]string{"Synchronize", "Trace"}, SLOW, 127, [2]string{"Amorphous", ""}, 235},
+	{"Kirlia", [2]string{"Psychic", ""}, BaseStats{38, 35, 35, 65, 55, 50}, [2]string{"Synchronize", "Trace"}, SLOW, 127, [2]string{"Amorphous", ""}, 120},
+	{"Gardevoir", [2]string{"Psychic", ""}, BaseStats{68, 65, 65, 125, 115, 80}, [2]string{"Synchronize", "Trace"}, SLOW, 127, [2]string{"Amorphous", ""}, 45},
+	{"Surskit", [2]string{"Bug", "Water"}, BaseStats{40, 30, 32, 50, 52, 65}, [2]string{"Swift Swim", ""}, MEDIUM_FAST, 127, [2]string{"Water 1", "Bug"}, 200},
+	{"Masquerain", [2]string{"Bug", "Flying"}, BaseStats{70, 60, 62, 80, 82, 60}, [2]string{"Intimidate", ""}, MEDIUM_FAST, 127, [2]string{"Water 1", "Bug"}, 75},
+	{"Shroomish", [2]string{"Grass", ""}, BaseStats{60, 40, 60, 40, 60, 35}, [2]string{"Effect Spore", "Poison Heal"}, FLUCTUATING, 127, [2]string{"Fairy", "Grass"}, 255},
+	{"Breloom", [2]string{"Grass", "Fighting"}, BaseStats{60, 130, 80, 60, 60, 70}, [2]string{"Effect Spore", "Poison Heal"}, FLUCTUATING, 127, [2]string{"Fairy", "Grass"}, 90},
+	{"Slakoth", [2]string{"Normal", ""}, BaseStats{60, 60, 60, 35, 35, 30}, [2]string{"Truant", ""}, SLOW, 127, [2]string{"Field", ""}, 255},
+	{"Vigoroth", [2]string{"Normal", ""}, BaseStats{80, 80, 80, 55, 55, 90}, [2]string{"Vital Spirit", ""}, SLOW, 127, [2]string{"Field", ""}, 120},
+	{"Slaking", [2]string{"Normal", ""}, BaseStats{150, 160, 100, 95, 65, 100}, [2]string{"Truant", ""}, SLOW, 127, [2]string{"Field", ""}, 45},
+	{"Nincada", [2]string{"Bug", "Ground"}, BaseStats{31, 45, 90, 30, 30, 40}, [2]string{"Compound Eyes", ""}, ERRATIC, 127, [2]string{"Bug", ""}, 255},
+	{"Ninjask", [2]string{"Bug", "Flying"}, BaseStats{61, 90, 45, 50, 50, 160}, [2]string{"Speed Boost", ""}, ERRATIC, 127, [2]string{"Bug", ""}, 120},
+	{"Shedinja", [2]string{"Bug", "Ghost"}, BaseStats{1, 90, 45, 30, 30, 40}, [2]string{"Wonder Guard", ""}, ERRATIC, GENDERLESS, [2]string{"Mineral", ""}, 45},
+	{"Whismur", [2]string{"Normal", ""}, BaseStats{64, 51, 23, 51, 23, 28}, [2]string{"Soundproof", ""}, MEDIUM_SLOW, 127, [2]string{"Monster", "Field"}, 190},
+	{"Loudred", [2]string{"Normal", ""}, BaseStats{84, 71, 43, 71, 43, 48}, [2]string{"Soundproof", ""}, MEDIUM_SLOW, 127, [2]string{"Monster", "Field"}, 120},
+	{"Exploud", [2]string{"Normal", ""}, BaseStats{104, 91, 63, 91, 63, 68}, [2]string{"Soundproof", ""}, MEDIUM_SLOW, 127, [2]string{"Monster", "Field"}, 45},
+	{"Makuhita", [2]string{"Fighting", ""}, BaseStats{72, 60, 30, 20, 30, 25}, [2]string{"Thick Fat", "Guts"}, FLUCTUATING, 63, [2]string{"Human-Like", ""}, 180},
+	{"Hariyama", [2]string{"Fighting", ""}, BaseStats{144, 120, 60, 40, 60, 50}, [2]string{"Thick Fat", "Guts"}, FLUCTUATING, 63, [2]string{"Human-Like", ""}, 200},
+	{"Azurill", [2]string{"Normal", ""}, BaseStats{50, 20, 40, 20, 40, 20}, [2]string{"Thick Fat", "Huge Power"}, FAST, 191, [2]string{"Undiscovered", ""}, 150},
+	{"Nosepass", [2]string{"Rock", ""}, BaseStats{30, 45, 135, 45, 90, 30}, [2]string{"Sturdy", "Magnet Pull"}, MEDIUM_FAST, 127, [2]string{"Mineral", ""}, 255},
+	{"Skitty", [2]string{"Normal", ""}, BaseStats{50, 45, 45, 35, 35, 50}, [2]string{"Cute Charm", "Normalize"}, FAST, 191, [2]string{"Field", "Fairy"}, 255},
+	{"Delcatty", [2]string{"Normal", ""}, BaseStats{70, 65, 65, 55, 55, 70}, [2]string{"Cute Charm", "Normalize"}, FAST, 191, [2]string{"Field", "Fairy"}, 60},
+	{"Sableye", [2]string{"Dark", "Ghost"}, BaseStats{50, 75, 75, 65, 65, 50}, [2]string{"Keen Eye", "Stall"}, MEDIUM_SLOW, 127, [2]string{"Human-Like", ""}, 45},
+	{"Mawile", [2]string{"Steel", ""}, BaseStats{50, 85, 85, 55, 55, 50}, [2]string{"Hyper Cutter", "Intimidate"}, FAST, 127, [2]string{"Field", "Fairy"}, 45},
+	{"Aron", [2]string{"Steel", "Rock"}, BaseStats{50, 70, 100, 40, 40, 30}, [2]string{"Sturdy", "Rock Head"}, SLOW, 127, [2]string{"Monster", ""}, 180},
+	{"Lairon", [2]string{"Steel", "Rock"}, BaseStats{60, 90, 140, 50, 50, 40}, [2]string{"Sturdy", "Rock Head"}, SLOW, 127, [2]string{"Monster", ""}, 90},
+	{"Aggron", [2]string{"Steel", "Rock"}, BaseStats{70, 110, 180, 60, 60, 50}, [2]string{"Sturdy", "Rock Head"}, SLOW, 127, [2]string{"Monster", ""}, 45},
+	{"Meditite", [2]string{"Fighting", "Psychic"}, BaseStats{30, 40, 55, 40, 55, 60}, [2]string{"Pure Power", ""}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 180},
+	{"Medicham", [2]string{"Fighting", "Psychic"}, BaseStats{60, 60, 75, 60, 75, 80}, [2]string{"Pure Power", ""}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 90},
+	{"Electrike", [2]string{"Electric", ""}, BaseStats{40, 45, 40, 65, 40, 65}, [2]string{"Static", "Lightning Rod"}, SLOW, 127, [2]string{"Field", ""}, 120},
+	{"Manectric", [2]string{"Electric", ""}, BaseStats{70, 75, 60, 105, 60, 105}, [2]string{"Static", "Lightning Rod"}, SLOW, 127, [2]string{"Field", ""}, 45},
+	{"Plusle", [2]string{"Electric", ""}, BaseStats{60, 50, 40, 85, 75, 95}, [2]string{"Plus", ""}, MEDIUM_FAST, 127, [2]string{"Fairy", ""}, 200},
+	{"Minun", [2]string{"Electric", ""}, BaseStats{60, 40, 50, 75, 85, 95}, [2]string{"Minus", ""}, MEDIUM_FAST, 127, [2]string{"Fairy", ""}, 200},
+	{"Volbeat", [2]string{"Bug", ""}, BaseStats{65, 73, 55, 47, 75, 85}, [2]string{"Illuminate", "Swarm"}, ERRATIC, MALE_ONLY, [2]string{"Bug", "Human-Like"}, 150},
+	{"Illumise", [2]string{"Bug", ""}, BaseStats{65, 47, 55, 73, 75, 85}, [2]string{"Oblivious", "Tinted Lens"}, FLUCTUATING, FEMALE_ONLY, [2]string{"Bug", "Human-Like"}, 150},
+	{"Roselia", [2]string{"Grass", "Poison"}, BaseStats{50, 60, 45, 100, 80, 65}, [2]string{"Natural Cure", "Poison Point"}, MEDIUM_SLOW, 127, [2]string{"Fairy", "Grass"}, 150},
+	{"Gulpin", [2]string{"Poison", ""}, BaseStats{70, 43, 53, 43, 53, 40}, [2]string{"Liquid Ooze", "Sticky Hold"}, FLUCTUATING, 127, [2]string{"Amorphous", ""}, 225},
+	{"Swalot", [2]string{"Poison", ""}, BaseStats{100, 73, 83, 73, 83, 55}, [2]string{"Liquid Ooze", "Sticky Hold"}, FLUCTUATING, 127, [2]string{"Amorphous", ""}, 75},
+	{"Carvanha", [2]string{"Water", "Dark"}, BaseStats{45, 90, 20, 65, 20, 65}, [2]string{"Rough Skin", ""}, SLOW, 127, [2]string{"Water 2", ""}, 225},
+	{"Sharpedo", [2]string{"Water", "Dark"}, BaseStats{70, 120, 40, 95, 40, 95}, [2]string{"Rough Skin", ""}, SLOW, 127, [2]string{"Water 2", ""}, 60},
+	{"Wailmer", [2]string{"Water", ""}, BaseStats{130, 70, 35, 70, 35, 60}, [2]string{"Water Veil", "Oblivious"}, FLUCTUATING, 127, [2]string{"Field", "Water 2"}, 125},
+	{"Wailord", [2]string{"Water", ""}, BaseStats{170, 90, 45, 90, 45, 60}, [2]string{"Water Veil", "Oblivious"}, FLUCTUATING, 127, [2]string{"Field", "Water 2"}, 60},
+	{"Numel", [2]string{"Fire", "Ground"}, BaseStats{60, 60, 40, 65, 45, 35}, [2]string{"Oblivious", "Simple"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 255},
+	{"Camerupt", [2]string{"Fire", "Ground"}, BaseStats{70, 100, 70, 105, 75, 40}, [2]string{"Magma Armor", "Solid Rock"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 150},
+	{"Torkoal", [2]string{"Fire", ""}, BaseStats{70, 85, 140, 85, 70, 20}, [2]string{"White Smoke", ""}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 90},
+	{"Spoink", [2]string{"Psychic", ""}, BaseStats{60, 25, 35, 70, 80, 60}, [2]string{"Thick Fat", "Own Tempo"}, FAST, 127, [2]string{"Field", ""}, 255},
+	{"Grumpig", [2]string{"Psychic", ""}, BaseStats{80, 45, 65, 90, 110, 80}, [2]string{"Thick Fat", "Own Tempo"}, FAST, 127, [2]string{"Field", ""}, 60},
+	{"Spinda", [2]string{"Normal", ""}, BaseStats{60, 60, 60, 60, 60, 60}, [2]string{"Own Tempo", "Tangled Feet"}, FAST, 127, [2]string{"Field", "Human-Like"}, 255},
+	{"Trapinch", [2]string{"Ground", ""}, BaseStats{45, 100, 45, 45, 45, 10}, [2]string{"Hyper Cutter", "Arena Trap"}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 255},
+	{"Vibrava", [2]string{"Ground", "Dragon"}, BaseStats{50, 70, 50, 50, 50, 70}, [2]string{"Levitate", ""}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 120},
+	{"Flygon", [2]string{"Ground", "Dragon"}, BaseStats{80, 100, 80, 80, 80, 100}, [2]string{"Levitate", ""}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 45},
+	{"Cacnea", [2]string{"Grass", ""}, BaseStats{50, 85, 40, 85, 40, 35}, [2]string{"Sand Veil", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", "Human-Like"}, 190},
+	{"Cacturne", [2]string{"Grass", "Dark"}, BaseStats{70, 115, 60, 115, 60, 55}, [2]string{"Sand Veil", ""}, MEDIUM_SLOW, 127, [2]string{"Grass", "Human-Like"}, 60},
+	{"Swablu", [2]string{"Normal", "Flying"}, BaseStats{45, 40, 60, 40, 75, 50}, [2]string{"Natural Cure", ""}, ERRATIC, 127, [2]string{"Flying", "Dragon"}, 255},
+	{"Altaria", [2]string{"Dragon", "Flying"}, BaseStats{75, 70, 90, 70, 105, 80}, [2]string{"Natural Cure", ""}, ERRATIC, 127, [2]string{"Flying", "Dragon"}, 45},
+	{"Zangoose", [2]string{"Normal", ""}, BaseStats{73, 115, 60, 60, 60, 90}, [2]string{"Immunity", ""}, ERRATIC, 127, [2]string{"Field", ""}, 90},
+	{"Seviper", [2]string{"Poison", ""}, BaseStats{73, 100, 60, 100, 60, 65}, [2]string{"Shed Skin", ""}, FLUCTUATING, 127, [2]string{"Field", "Dragon"}, 90},
+	{"Lunatone", [2]string{"Rock", "Psychic"}, BaseStats{70, 55, 65, 95, 85, 70}, [2]string{"Levitate", ""}, FAST, GENDERLESS, [2]string{"Mineral", ""}, 45},
+	{"Solrock", [2]string{"Rock", "Psychic"}, BaseStats{70, 95, 85, 55, 65, 70}, [2]string{"Levitate", ""}, FAST, GENDERLESS, [2]string{"Mineral", ""}, 45},
+	{"Barboach", [2]string{"Water", "Ground"}, BaseStats{50, 48, 43, 46, 41, 60}, [2]string{"Oblivious", "Anticipation"}, MEDIUM_FAST, 127, [2]string{"Water 2", ""}, 190},
+	{"Whiscash", [2]string{"Water", "Ground"}, BaseStats{110, 78, 73, 76, 71, 60}, [2]string{"Oblivious", "Anticipation"}, MEDIUM_FAST, 127, [2]string{"Water 2", ""}, 75},
+	{"Corphish", [2]string{"Water", ""}, BaseStats{43, 80, 65, 50, 35, 35}, [2]string{"Hyper Cutter", "Shell Armor"}, FLUCTUATING, 127, [2]string{"Water 1", "Water 3"}, 205},
+	{"Crawdaunt", [2]string{"Water", "Dark"}, BaseStats{63, 120, 85, 90, 55, 55}, [2]string{"Hyper Cutter", "Shell Armor"}, FLUCTUATING, 127, [2]string{"Water 1", "Water 3"}, 155},
+	{"Baltoy", [2]string{"Ground", "Psychic"}, BaseStats{40, 40, 55, 40, 70, 55}, [2]string{"Levitate", ""}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 255},
+	{"Claydol", [2]string{"Ground", "Psychic"}, BaseStats{60, 70, 105, 70, 120, 75}, [2]string{"Levitate", ""}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 90},
+	{"Lileep", [2]string{"Rock", "Grass"}, BaseStats{66, 41, 77, 61, 87, 23}, [2]string{"Suction Cups", ""}, ERRATIC, 31, [2]string{"Water 3", ""}, 45},
+	{"Cradily", [2]string{"Rock", "Grass"}, BaseStats{86, 81, 97, 81, 107, 43}, [2]string{"Suction Cups", ""}, ERRATIC, 31, [2]string{"Water 3", ""}, 45},
+	{"Anorith", [2]string{"Rock", "Bug"}, BaseStats{45, 95, 50, 40, 50, 75}, [2]string{"Battle Armor", ""}, ERRATIC, 31, [2]string{"Water 3", ""}, 45},
+	{"Armaldo", [2]string{"Rock", "Bug"}, BaseStats{75, 125, 100, 70, 80, 45}, [2]string{"Battle Armor", ""}, ERRATIC, 31, [2]string{"Water 3", ""}, 45},
+	{"Feebas", [2]string{"Water", ""}, BaseStats{20, 15, 20, 10, 55, 80}, [2]string{"Swift Swim", ""}, ERRATIC, 127, [2]string{"Water 1", "Dragon"}, 255},
+	{"Milotic", [2]string{"Water", ""}, BaseStats{95, 60, 79, 100, 125, 81}, [2]string{"Marvel Scale", ""}, ERRATIC, 127, [2]string{"Water 1", "Dragon"}, 60},
+	{"Castform", [2]string{"Normal", ""}, BaseStats{70, 70, 70, 70, 70, 70}, [2]string{"Forecast", ""}, MEDIUM_FAST, 127, [2]string{"Fairy", "Amorphous"}, 45},
+	{"Kecleon", [2]string{"Normal", ""}, BaseStats{60, 90, 70, 60, 120, 40}, [2]string{"Color Change", ""}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 200},
+	{"Shuppet", [2]string{"Ghost", ""}, BaseStats{44, 75, 35, 63, 33, 45}, [2]string{"Insomnia", "Frisk"}, FAST, 127, [2]string{"Amorphous", ""}, 225},
+	{"Banette", [2]string{"Ghost", ""}, BaseStats{64, 115, 65, 83, 63, 65}, [2]string{"Insomnia", "Frisk"}, FAST, 127, [2]string{"Amorphous", ""}, 45},
+	{"Duskull", [2]string{"Ghost", ""}, BaseStats{20, 40, 90, 30, 90, 25}, [2]string{"Levitate", ""}, FAST, 127, [2]string{"Amorphous", ""}, 190},
+	{"Dusclops", [2]string{"Ghost", ""}, BaseStats{40, 70, 130, 60, 130, 25}, [2]string{"Pressure", ""}, FAST, 127, [2]string{"Amorphous", ""}, 90},
+	{"Tropius", [2]string{"Grass", "Flying"}, BaseStats{99, 68, 83, 72, 87, 51}, [2]string{"Chlorophyll", "Solar Power"}, SLOW, 127, [2]string{"Monster", "Grass"}, 200},
+	{"Chimecho", [2]string{"Psychic", ""}, BaseStats{65, 50, 70, 95, 80, 65}, [2]string{"Levitate", ""}, FAST, 127, [2]string{"Amorphous", ""}, 45},
+	{"Absol", [2]string{"Dark", ""}, BaseStats{65, 130, 60, 75, 60, 75}, [2]string{"Pressure", "Super Luck"}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 30},
+	{"Wynaut", [2]string{"Psychic", ""}, BaseStats{95, 23, 48, 23, 48, 23}, [2]string{"Shadow Tag", ""}, MEDIUM_FAST, 127, [2]string{"Undiscovered", ""}, 125},
+	{"Snorunt", [2]string{"Ice", ""}, BaseStats{50, 50, 50, 50, 50, 50}, [2]string{"Inner Focus", "Ice Body"}, MEDIUM_FAST, 127, [2]string{"Fairy", "Mineral"}, 190},
+	{"Glalie", [2]string{"Ice", ""}, BaseStats{80, 80, 80, 80, 80, 80}, [2]string{"Inner Focus", "Ice Body"}, MEDIUM_FAST, 127, [2]string{"Fairy", "Mineral"}, 75},
+	{"Spheal", [2]string{"Ice", "Water"}, BaseStats{70, 40, 50, 55, 50, 25}, [2]string{"Thick Fat", "Ice Body"}, MEDIUM_SLOW, 127, [2]string{"Water 1", "Field"}, 255},
+	{"Sealeo", [2]string{"Ice", "Water"}, BaseStats{90, 60, 70, 75, 70, 45}, [2]string{"Thick Fat", "Ice Body"}, MEDIUM_SLOW, 127, [2]string{"Water 1", "Field"}, 120},
+	{"Walrein", [2]string{"Ice", "Water"}, BaseStats{110, 80, 90, 95, 90, 65}, [2]string{"Thick Fat", "Ice Body"}, MEDIUM_SLOW, 127, [2]string{"Water 1", "Field"}, 45},
+	{"Clamperl", [2]string{"Water", ""}, BaseStats{35, 64, 85, 74, 55, 32}, [2]string{"Shell Armor", ""}, ERRATIC, 127, [2]string{"Water 1", ""}, 255},
+	{"Huntail", [2]string{"Water", ""}, BaseStats{55, 104, 105, 94, 75, 52}, [2]string{"Swift Swim", ""}, ERRATIC, 127, [2]string{"Water 1", ""}, 60},
+	{"Gorebyss", [2]string{"Water", ""}, BaseStats{55, 84, 105, 114, 75, 52}, [2]string{"Swift Swim", ""}, ERRATIC, 127, [2]string{"Water 1", ""}, 60},
+	{"Relicanth", [2]string{"Water", "Rock"}, BaseStats{100, 90, 130, 45, 65, 55}, [2]string{"Swift Swim", "Rock Head"}, SLOW, 31, [2]string{"Water 1", "Water 2"}, 25},
+	{"Luvdisc", [2]string{"Water", ""}, BaseStats{43, 30, 55, 40, 65, 97}, [2]string{"Swift Swim", ""}, FAST, 191, [2]string{"Water 2", ""}, 225},
+	{"Bagon", [2]string{"Dragon", ""}, BaseStats{45, 75, 60, 40, 30, 50}, [2]string{"Rock Head", ""}, SLOW, 127, [2]string{"Dragon", ""}, 45},
+	{"Shelgon", [2]string{"Dragon", ""}, BaseStats{65, 95, 100, 60, 50, 50}, [2]string{"Rock Head", ""}, SLOW, 127, [2]string{"Dragon", ""}, 45},
+	{"Salamence", [2]string{"Dragon", "Flying"}, BaseStats{95, 135, 80, 110, 80, 100}, [2]string{"Intimidate", ""}, SLOW, 127, [2]string{"Dragon", ""}, 45},
+	{"Beldum", [2]string{"Steel", "Psychic"}, BaseStats{40, 55, 80, 35, 60, 30}, [2]string{"Clear Body", ""}, SLOW, GENDERLESS, [2]string{"Mineral", ""}, 3},
+	{"Metang", [2]string{"Steel", "Psychic"}, BaseStats{60, 75, 100, 55, 80, 50}, [2]string{"Clear Body", ""}, SLOW, GENDERLESS, [2]string{"Mineral", ""}, 3},
+	{"Metagross", [2]string{"Steel", "Psychic"}, BaseStats{80, 135, 130, 95, 90, 70}, [2]string{"Clear Body", ""}, SLOW, GENDERLESS, [2]string{"Mineral", ""}, 3},
+	{"Regirock", [2]string{"Rock", ""}, BaseStats{80, 100, 200, 50, 100, 50}, [2]string{"Clear Body", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Regice", [2]string{"Ice", ""}, BaseStats{80, 50, 100, 100, 200, 50}, [2]string{"Clear Body", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Registeel", [2]string{"Steel", ""}, BaseStats{80, 75, 150, 75, 150, 50}, [2]string{"Clear Body", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Latias", [2]string{"Dragon", "Psychic"}, BaseStats{80, 80, 90, 110, 130, 110}, [2]string{"Levitate", ""}, SLOW, FEMALE_ONLY, [2]string{"Undiscovered", ""}, 3},
+	{"Latios", [2]string{"Dragon", "Psychic"}, BaseStats{80, 90, 80, 130, 110, 110}, [2]string{"Levitate", ""}, SLOW, MALE_ONLY, [2]string{"Undiscovered", ""}, 3},
+	{"Kyogre", [2]string{"Water", ""}, BaseStats{100, 100, 90, 150, 140, 90}, [2]string{"Drizzle", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Groudon", [2]string{"Ground", ""}, BaseStats{100, 150, 140, 100, 90, 90}, [2]string{"Drought", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Rayquaza", [2]string{"Dragon", "Flying"}, BaseStats{105, 150, 90, 150, 90, 95}, [2]string{"Air Lock", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 45},
+	{"Jirachi", [2]string{"Steel", "Psychic"}, BaseStats{100, 100, 100, 100, 100, 100}, [2]string{"Serene Grace", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Deoxys", [2]string{"Psychic", ""}, BaseStats{50, 150, 50, 150, 50, 150}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Turtwig", [2]string{"Grass", ""}, BaseStats{55, 68, 64, 45, 55, 31}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Grotle", [2]string{"Grass", ""}, BaseStats{75, 89, 85, 55, 65, 36}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Torterra", [2]string{"Grass", "Ground"}, BaseStats{95, 109, 105, 75, 85, 56}, [2]string{"Overgrow", ""}, MEDIUM_SLOW, 31, [2]string{"Monster", "Grass"}, 45},
+	{"Chimchar", [2]string{"Fire", ""}, BaseStats{44, 58, 44, 58, 44, 61}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", "Human-Like"}, 45},
+	{"Monferno", [2]string{"Fire", "Fighting"}, BaseStats{64, 78, 52, 78, 52, 81}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", "Human-Like"}, 45},
+	{"Infernape", [2]string{"Fire", "Fighting"}, BaseStats{76, 104, 71, 104, 71, 108}, [2]string{"Blaze", ""}, MEDIUM_SLOW, 31, [2]string{"Field", "Human-Like"}, 45},
+	{"Piplup", [2]string{"Water", ""}, BaseStats{53, 51, 53, 61, 56, 40}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Water 1", "Field"}, 45},
+	{"Prinplup", [2]string{"Water", ""}, BaseStats{64, 66, 68, 81, 76, 50}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Water 1", "Field"}, 45},
+	{"Empoleon", [2]string{"Water", "Steel"}, BaseStats{84, 86, 88, 111, 101, 60}, [2]string{"Torrent", ""}, MEDIUM_SLOW, 31, [2]string{"Water 1", "Field"}, 45},
+	{"Starly", [2]string{"Normal", "Flying"}, BaseStats{40, 55, 30, 30, 30, 60}, [2]string{"Keen Eye", ""}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 255},
+	{"Staravia", [2]string{"Normal", "Flying"}, BaseStats{55, 75, 50, 40, 40, 80}, [2]string{"Intimidate", ""}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 120},
+	{"Staraptor", [2]string{"Normal", "Flying"}, BaseStats{85, 120, 70, 50, 60, 100}, [2]string{"Intimidate", ""}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 45},
+	{"Bidoof", [2]string{"Normal", ""}, BaseStats{59, 45, 40, 35, 40, 31}, [2]string{"Simple", "Unaware"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 255},
+	{"Bibarel", [2]string{"Normal", "Water"}, BaseStats{79, 85, 60, 55, 60, 71}, [2]string{"Simple", "Unaware"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 127},
+	{"Kricketot", [2]string{"Bug", ""}, BaseStats{37, 25, 41, 25, 41, 25}, [2]string{"Shed Skin", ""}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 255},
+	{"Kricketune", [2]string{"Bug", ""}, BaseStats{77, 85, 51, 55, 51, 65}, [2]string{"Swarm", ""}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 45},
+	{"Shinx", [2]string{"Electric", ""}, BaseStats{45, 65, 34, 40, 34, 45}, [2]string{"Rivalry", "Intimidate"}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 235},
+	{"Luxio", [2]string{"Electric", ""}, BaseStats{60, 85, 49, 60, 49, 60}, [2]string{"Rivalry", "Intimidate"}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 120},
+	{"Luxray", [2]string{"Electric", ""}, BaseStats{80, 120, 79, 95, 79, 70}, [2]string{"Rivalry", "Intimidate"}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 45},
+	{"Budew", [2]string{"Grass", "Poison"}, BaseStats{40, 30, 35, 50, 70, 55}, [2]string{"Natural Cure", "Poison Point"}, MEDIUM_SLOW, 127, [2]string{"Undiscovered", ""}, 255},
+	{"Roserade", [2]string{"Grass", "Poison"}, BaseStats{60, 70, 55, 125, 105, 90}, [2]string{"Natural Cure", "Poison Point"}, MEDIUM_SLOW, 127, [2]string{"Fairy", "Grass"}, 75},
+	{"Cranidos", [2]string{"Rock", ""}, BaseStats{67, 125, 40, 30, 30, 58}, [2]string{"Mold Breaker", ""}, ERRATIC, 31, [2]string{"Monster", ""}, 45},
+	{"Rampardos", [2]string{"Rock", ""}, BaseStats{97, 165, 60, 65, 50, 58}, [2]string{"Mold Breaker", ""}, ERRATIC, 31, [2]string{"Monster", ""}, 45},
+	{"Shieldon", [2]string{"Rock", "Steel"}, BaseStats{30, 42, 118, 42, 88, 30}, [2]string{"Sturdy", ""}, ERRATIC, 31, [2]string{"Monster", ""}, 45},
+	{"Bastiodon", [2]string{"Rock", "Steel"}, BaseStats{60, 52, 168, 47, 138, 30}, [2]string{"Sturdy", ""}, ERRATIC, 31, [2]string{"Monster", ""}, 45},
+	{"Burmy", [2]string{"Bug", ""}, BaseStats{40, 29, 45, 29, 45, 36}, [2]string{"Shed Skin", ""}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 120},
+	{"Wormadam", [2]string{"Bug", "Grass"}, BaseStats{60, 59, 85, 79, 105, 36}, [2]string{"Anticipation", ""}, MEDIUM_FAST, FEMALE_ONLY, [2]string{"Bug", ""}, 45},
+	{"Mothim", [2]string{"Bug", "Flying"}, BaseStats{70, 94, 50, 94, 50, 66}, [2]string{"Swarm", ""}, MEDIUM_FAST, MALE_ONLY, [2]string{"Bug", ""}, 45},
+	{"Combee", [2]string{"Bug", "Flying"}, BaseStats{30, 30, 42, 30, 42, 70}, [2]string{"Honey Gather", ""}, MEDIUM_SLOW, 31, [2]string{"Bug", ""}, 120},
+	{"Vespiquen", [2]string{"Bug", "Flying"}, BaseStats{70, 80, 102, 80, 102, 40}, [2]string{"Pressure", ""}, MEDIUM_SLOW, FEMALE_ONLY, [2]string{"Bug", ""}, 45},
+	{"Pachirisu", [2]string{"Electric", ""}, BaseStats{60, 45, 70, 45, 90, 95}, [2]string{"Run Away", "Pickup"}, MEDIUM_FAST, 127, [2]string{"Field", "Fairy"}, 200},
+	{"Buizel", [2]string{"Water", ""}, BaseStats{55, 65, 35, 60, 30, 85}, [2]string{"Swift Swim", ""}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 190},
+	{"Floatzel", [2]string{"Water", ""}, BaseStats{85, 105, 55, 85, 50, 115}, [2]string{"Swift Swim", ""}, MEDIUM_FAST, 127, [2]string{"Water 1", "Field"}, 75},
+	{"Cherubi", [2]string{"Grass", ""}, BaseStats{45, 35, 45, 62, 53, 35}, [2]string{"Chlorophyll", ""}, MEDIUM_FAST, 127, [2]string{"Fairy", "Grass"}, 190},
+	{"Cherrim", [2]string{"Grass", ""}, BaseStats{70, 60, 70, 87, 78, 85}, [2]string{"Flower Gift", ""}, MEDIUM_FAST, 127, [2]string{"Fairy", "Grass"}, 75},
+	{"Shellos", [2]string{"Water", ""}, BaseStats{76, 48, 48, 57, 62, 34}, [2]string{"Sticky Hold", "Storm Drain"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Amorphous"}, 190},
+	{"Gastrodon", [2]string{"Water", "Ground"}, BaseStats{111, 83, 68, 92, 82, 39}, [2]string{"Sticky Hold", "Storm Drain"}, MEDIUM_FAST, 127, [2]string{"Water 1", "Amorphous"}, 75},
+	{"Ambipom", [2]string{"Normal", ""}, BaseStats{75, 100, 66, 60, 66, 115}, [2]string{"Technician", "Pickup"}, FAST, 127, [2]string{"Field", ""}, 45},
+	{"Drifloon", [2]string{"Ghost", "Flying"}, BaseStats{90, 50, 34, 60, 44, 70}, [2]string{"Aftermath", "Unburden"}, FLUCTUATING, 127, [2]string{"Amorphous", ""}, 125},
+	{"Drifblim", [2]string{"Ghost", "Flying"}, BaseStats{150, 80, 44, 90, 54, 80}, [2]string{"Aftermath", "Unburden"}, FLUCTUATING, 127, [2]string{"Amorphous", ""}, 60},
+	{"Buneary", [2]string{"Normal", ""}, BaseStats{55, 66, 44, 44, 56, 85}, [2]string{"Run Away", "Klutz"}, MEDIUM_FAST, 127, [2]string{"Field", "Human-Like"}, 190},
+	{"Lopunny", [2]string{"Normal", ""}, BaseStats{65, 76, 84, 54, 96, 105}, [2]string{"Cute Charm", "Klutz"}, MEDIUM_FAST, 127, [2]string{"Field", "Human-Like"}, 60},
+	{"Mismagius", [2]string{"Ghost", ""}, BaseStats{60, 60, 60, 105, 105, 105}, [2]string{"Levitate", ""}, FAST, 127, [2]string{"Amorphous", ""}, 45},
+	{"Honchkrow", [2]string{"Dark", "Flying"}, BaseStats{100, 125, 52, 105, 52, 71}, [2]string{"Insomnia", "Super Luck"}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 30},
+	{"Glameow", [2]string{"Normal", ""}, BaseStats{49, 55, 42, 42, 37, 85}, [2]string{"Limber", "Own Tempo"}, FAST, 191, [2]string{"Field", ""}, 190},
+	{"Purugly", [2]string{"Normal", ""}, BaseStats{71, 82, 64, 64, 59, 112}, [2]string{"Thick Fat", "Own Tempo"}, FAST, 191, [2]string{"Field", ""}, 75},
+	{"Chingling", [2]string{"Psychic", ""}, BaseStats{45, 30, 50, 65, 50, 45}, [2]string{"Levitate", ""}, FAST, 127, [2]string{"Undiscovered", ""}, 120},
+	{"Stunky", [2]string{"Poison", "Dark"}, BaseStats{63, 63, 47, 41, 41, 74}, [2]string{"Stench", "Aftermath"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 225},
+	{"Skuntank", [2]string{"Poison", "Dark"}, BaseStats{103, 93, 67, 71, 61, 84}, [2]string{"Stench", "Aftermath"}, MEDIUM_FAST, 127, [2]string{"Field", ""}, 60},
+	{"Bronzor", [2]string{"Steel", "Psychic"}, BaseStats{57, 24, 86, 24, 86, 23}, [2]string{"Levitate", "Heatproof"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 255},
+	{"Bronzong", [2]string{"Steel", "Psychic"}, BaseStats{67, 89, 116, 79, 116, 33}, [2]string{"Levitate", "Heatproof"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 90},
+	{"Bonsly", [2]string{"Rock", ""}, BaseStats{50, 80, 95, 10, 45, 10}, [2]string{"Sturdy", "Rock Head"}, MEDIUM_FAST, 127, [2]string{"Undiscovered", ""}, 255},
+	{"Mime Jr.", [2]string{"Psychic", ""}, BaseStats{20, 25, 45, 70, 90, 60}, [2]string{"Soundproof", "Filter"}, MEDIUM_FAST, 127, [2]string{"Undiscovered", ""}, 145},
+	{"Happiny", [2]string{"Normal", ""}, BaseStats{100, 5, 5, 15, 65, 30}, [2]string{"Natural Cure", "Serene Grace"}, FAST, FEMALE_ONLY, [2]string{"Undiscovered", ""}, 130},
+	{"Chatot", [2]string{"Normal", "Flying"}, BaseStats{76, 65, 45, 92, 42, 91}, [2]string{"Keen Eye", "Tangled Feet"}, MEDIUM_SLOW, 127, [2]string{"Flying", ""}, 30},
+	{"Spiritomb", [2]string{"Ghost", "Dark"}, BaseStats{50, 92, 108, 92, 108, 35}, [2]string{"Pressure", ""}, MEDIUM_FAST, 127, [2]string{"Amorphous", ""}, 100},
+	{"Gible", [2]string{"Dragon", "Ground"}, BaseStats{58, 70, 45, 40, 45, 42}, [2]string{"Sand Veil", ""}, SLOW, 127, [2]string{"Monster", "Dragon"}, 45},
+	{"Gabite", [2]string{"Dragon", "Ground"}, BaseStats{68, 90, 65, 50, 55, 82}, [2]string{"Sand Veil", ""}, SLOW, 127, [2]string{"Monster", "Dragon"}, 45},
+	{"Garchomp", [2]string{"Dragon", "Ground"}, BaseStats{108, 130, 95, 80, 85, 102}, [2]string{"Sand Veil", ""}, SLOW, 127, [2]string{"Monster", "Dragon"}, 45},
+	{"Munchlax", [2]string{"Normal", ""}, BaseStats{135, 85, 40, 40, 85, 5}, [2]string{"Pickup", "Thick Fat"}, SLOW, 31, [2]string{"Undiscovered", ""}, 50},
+	{"Riolu", [2]string{"Fighting", ""}, BaseStats{40, 70, 40, 35, 40, 60}, [2]string{"Steadfast", "Inner Focus"}, MEDIUM_SLOW, 31, [2]string{"Undiscovered", ""}, 75},
+	{"Lucario", [2]string{"Fighting", "Steel"}, BaseStats{70, 110, 70, 115, 70, 90}, [2]string{"Steadfast", "Inner Focus"}, MEDIUM_SLOW, 31, [2]string{"Field", "Human-Like"}, 45},
+	{"Hippopotas", [2]string{"Ground", ""}, BaseStats{68, 72, 78, 38, 42, 32}, [2]string{"Sand Stream", ""}, SLOW, 127, [2]string{"Field", ""}, 140},
+	{"Hippowdon", [2]string{"Ground", ""}, BaseStats{108, 112, 118, 68, 72, 47}, [2]string{"Sand Stream", ""}, SLOW, 127, [2]string{"Field", ""}, 60},
+	{"Skorupi", [2]string{"Poison", "Bug"}, BaseStats{40, 50, 90, 30, 55, 65}, [2]string{"Battle Armor", "Sniper"}, SLOW, 127, [2]string{"Bug", "Water 3"}, 120},
+	{"Drapion", [2]string{"Poison", "Dark"}, BaseStats{70, 90, 110, 60, 75, 95}, [2]string{"Battle Armor", "Sniper"}, SLOW, 127, [2]string{"Bug", "Water 3"}, 45},
+	{"Croagunk", [2]string{"Poison", "Fighting"}, BaseStats{48, 61, 40, 61, 40, 50}, [2]string{"Anticipation", "Dry Skin"}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 140},
+	{"Toxicroak", [2]string{"Poison", "Fighting"}, BaseStats{83, 106, 65, 86, 65, 85}, [2]string{"Anticipation", "Dry Skin"}, MEDIUM_FAST, 127, [2]string{"Human-Like", ""}, 75},
+	{"Carnivine", [2]string{"Grass", ""}, BaseStats{74, 100, 72, 90, 72, 46}, [2]string{"Levitate", ""}, SLOW, 127, [2]string{"Grass", ""}, 200},
+	{"Finneon", [2]string{"Water", ""}, BaseStats{49, 49, 56, 49, 61, 66}, [2]string{"Swift Swim", "Storm Drain"}, ERRATIC, 127, [2]string{"Water 2", ""}, 190},
+	{"Lumineon", [2]string{"Water", ""}, BaseStats{69, 69, 76, 69, 86, 91}, [2]string{"Swift Swim", "Storm Drain"}, ERRATIC, 127, [2]string{"Water 2", ""}, 75},
+	{"Mantyke", [2]string{"Water", "Flying"}, BaseStats{45, 20, 50, 60, 120, 50}, [2]string{"Swift Swim", "Water Absorb"}, SLOW, 127, [2]string{"Undiscovered", ""}, 25},
+	{"Snover", [2]string{"Grass", "Ice"}, BaseStats{60, 62, 50, 62, 60, 40}, [2]string{"Snow Warning", ""}, SLOW, 127, [2]string{"Monster", "Grass"}, 120},
+	{"Abomasnow", [2]string{"Grass", "Ice"}, BaseStats{90, 92, 75, 92, 85, 60}, [2]string{"Snow Warning", ""}, SLOW, 127, [2]string{"Monster", "Grass"}, 60},
+	{"Weavile", [2]string{"Dark", "Ice"}, BaseStats{70, 120, 65, 45, 85, 125}, [2]string{"Pressure", ""}, MEDIUM_SLOW, 127, [2]string{"Field", ""}, 45},
+	{"Magnezone", [2]string{"Electric", "Steel"}, BaseStats{70, 70, 115, 130, 90, 60}, [2]string{"Magnet Pull", "Sturdy"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 30},
+	{"Lickilicky", [2]string{"Normal", ""}, BaseStats{110, 85, 95, 80, 95, 50}, [2]string{"Own Tempo", "Oblivious"}, MEDIUM_FAST, 127, [2]string{"Monster", ""}, 30},
+	{"Rhyperior", [2]string{"Ground", "Rock"}, BaseStats{115, 140, 130, 55, 55, 40}, [2]string{"Lightning Rod", "Solid Rock"}, SLOW, 127, [2]string{"Monster", "Field"}, 30},
+	{"Tangrowth", [2]string{"Grass", ""}, BaseStats{100, 100, 125, 110, 50, 50}, [2]string{"Chlorophyll", "Leaf Guard"}, MEDIUM_FAST, 127, [2]string{"Grass", ""}, 30},
+	{"Electivire", [2]string{"Electric", ""}, BaseStats{75, 123, 67, 95, 85, 95}, [2]string{"Motor Drive", ""}, MEDIUM_FAST, 63, [2]string{"Human-Like", ""}, 30},
+	{"Magmortar", [2]string{"Fire", ""}, BaseStats{75, 95, 67, 125, 95, 83}, [2]string{"Flame Body", ""}, MEDIUM_FAST, 63, [2]string{"Human-Like", ""}, 30},
+	{"Togekiss", [2]string{"Normal", "Flying"}, BaseStats{85, 50, 95, 120, 115, 80}, [2]string{"Hustle", "Serene Grace"}, FAST, 31, [2]string{"Flying", "Fairy"}, 30},
+	{"Yanmega", [2]string{"Bug", "Flying"}, BaseStats{86, 76, 86, 116, 56, 95}, [2]string{"Speed Boost", "Tinted Lens"}, MEDIUM_FAST, 127, [2]string{"Bug", ""}, 30},
+	{"Leafeon", [2]string{"Grass", ""}, BaseStats{65, 110, 130, 60, 65, 95}, [2]string{"Leaf Guard", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Glaceon", [2]string{"Ice", ""}, BaseStats{65, 60, 110, 130, 95, 65}, [2]string{"Snow Cloak", ""}, MEDIUM_FAST, 31, [2]string{"Field", ""}, 45},
+	{"Gliscor", [2]string{"Ground", "Flying"}, BaseStats{75, 95, 125, 45, 75, 95}, [2]string{"Hyper Cutter", "Sand Veil"}, MEDIUM_SLOW, 127, [2]string{"Bug", ""}, 30},
+	{"Mamoswine", [2]string{"Ice", "Ground"}, BaseStats{110, 130, 80, 70, 60, 80}, [2]string{"Oblivious", "Snow Cloak"}, SLOW, 127, [2]string{"Field", ""}, 50},
+	{"Porygon-Z", [2]string{"Normal", ""}, BaseStats{85, 80, 70, 135, 75, 90}, [2]string{"Adaptability", "Download"}, MEDIUM_FAST, GENDERLESS, [2]string{"Mineral", ""}, 30},
+	{"Gallade", [2]string{"Psychic", "Fighting"}, BaseStats{68, 125, 65, 65, 115, 80}, [2]string{"Steadfast", ""}, SLOW, MALE_ONLY, [2]string{"Amorphous", ""}, 45},
+	{"Probopass", [2]string{"Rock", "Steel"}, BaseStats{60, 55, 145, 75, 150, 40}, [2]string{"Sturdy", "Magnet Pull"}, MEDIUM_FAST, 127, [2]string{"Mineral", ""}, 60},
+	{"Dusknoir", [2]string{"Ghost", ""}, BaseStats{45, 100, 135, 65, 135, 45}, [2]string{"Pressure", ""}, FAST, 127, [2]string{"Amorphous", ""}, 45},
+	{"Froslass", [2]string{"Ice", "Ghost"}, BaseStats{70, 80, 70, 80, 70, 110}, [2]string{"Snow Cloak", ""}, MEDIUM_FAST, FEMALE_ONLY, [2]string{"Fairy", "Mineral"}, 75},
+	{"Rotom", [2]string{"Electric", "Ghost"}, BaseStats{50, 50, 77, 95, 77, 91}, [2]string{"Levitate", ""}, MEDIUM_FAST, GENDERLESS, [2]string{"Amorphous", ""}, 45},
+	{"Uxie", [2]string{"Psychic", ""}, BaseStats{75, 75, 130, 75, 130, 95}, [2]string{"Levitate", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Mesprit", [2]string{"Psychic", ""}, BaseStats{80, 105, 105, 105, 105, 80}, [2]string{"Levitate", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Azelf", [2]string{"Psychic", ""}, BaseStats{75, 125, 70, 125, 70, 115}, [2]string{"Levitate", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Dialga", [2]string{"Steel", "Dragon"}, BaseStats{100, 120, 120, 150, 100, 90}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 30},
+	{"Palkia", [2]string{"Water", "Dragon"}, BaseStats{90, 120, 100, 150, 120, 100}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 30},
+	{"Heatran", [2]string{"Fire", "Steel"}, BaseStats{91, 90, 106, 130, 106, 77}, [2]string{"Flash Fire", ""}, SLOW, 127, [2]string{"Undiscovered", ""}, 3},
+	{"Regigigas", [2]string{"Normal", ""}, BaseStats{110, 160, 110, 80, 110, 100}, [2]string{"Slow Start", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Giratina", [2]string{"Ghost", "Dragon"}, BaseStats{150, 100, 120, 100, 120, 90}, [2]string{"Pressure", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Cresselia", [2]string{"Psychic", ""}, BaseStats{120, 70, 120, 75, 130, 85}, [2]string{"Levitate", ""}, SLOW, FEMALE_ONLY, [2]string{"Undiscovered", ""}, 3},
+	{"Phione", [2]string{"Water", ""}, BaseStats{80, 80, 80, 80, 80, 80}, [2]string{"Hydration", ""}, SLOW, GENDERLESS, [2]string{"Fairy", "Water 1"}, 30},
+	{"Manaphy", [2]string{"Water", ""}, BaseStats{100, 100, 100, 100, 100, 100}, [2]string{"Hydration", ""}, SLOW, GENDERLESS, [2]string{"Fairy", "Water 1"}, 3},
+	{"Darkrai", [2]string{"Dark", ""}, BaseStats{70, 90, 90, 135, 90, 125}, [2]string{"Bad Dreams", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+	{"Shaymin", [2]string{"Grass", ""}, BaseStats{100, 100, 100, 100, 100, 100}, [2]string{"Natural Cure", ""}, MEDIUM_SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 45},
+	{"Arceus", [2]string{"Normal", ""}, BaseStats{120, 120, 120, 120, 120, 120}, [2]string{"Multitype", ""}, SLOW, GENDERLESS, [2]string{"Undiscovered", ""}, 3},
+}
+
+func GetSpecies(dexId uint16) (SpeciesInfo, error) {
+	if dexId == 0 || dexId >= uint16(len(speciesTable)) {
+		return SpeciesInfo{}, errors.New("invalid index")
+	}
+
+	return speciesTable[dexId], nil
+}
+
+// maps species names to their pokedex ID
+func GenerateSpeciesMap() map[string]uint16 {
+	speciesMap := make(map[string]uint16)
+
+	for i, s := range speciesTable[1:] {
+		speciesMap[s.Name] = uint16(i + 1)
+	}
+
+	return speciesMap
+}
+
+// true if the species can have the ability, in either ability slot
+func (si SpeciesInfo) HasAbility(ability string) bool {
+	return ability != "" && (si.Abilities[0] == ability || si.Abilities[1] == ability)
+}
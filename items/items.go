@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"errors"
-	"fmt"
 	"log"
 	"os"
 	"path/filepath"
@@ -19,7 +18,6 @@ var cache []string
 
 func GetItemName(index uint16) (string, error) {
 	if len(cache) == 0 {
-		fmt.Println("populating cache")
 		path := filepath.Join(path_resolver.GetRoot(), "data", "items-gen4.txt")
 
 		b, err := os.ReadFile(path)
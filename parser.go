@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"github.com/dingdongg/pkmn-rom-parser/v7/container"
 	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
 	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer"
 	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
@@ -8,20 +9,241 @@ import (
 )
 
 func Parse(savefile []byte) ([]rom_reader.Pokemon, error) {
-	game, err := sav.Validate(savefile)
+	game, _, err := load(savefile)
 	if err != nil {
 		return []rom_reader.Pokemon{}, err
 	}
 
-	partyData := rom_reader.GetPartyPokemon(game)
-	return partyData, nil
+	return rom_reader.GetPartyPokemon(game)
 }
 
+func ParseTrainer(savefile []byte) (rom_reader.Trainer, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return rom_reader.Trainer{}, err
+	}
+
+	return rom_reader.GetTrainer(game)
+}
+
+// Only occupied slots are returned
+func ParseBoxes(savefile []byte) ([]rom_reader.BoxPokemon, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return []rom_reader.BoxPokemon{}, err
+	}
+
+	return rom_reader.GetBoxPokemon(game)
+}
+
+func ParseBoxMetadata(savefile []byte) (rom_reader.Boxes, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return rom_reader.Boxes{}, err
+	}
+
+	return rom_reader.GetBoxes(game)
+}
+
+func ParseBag(savefile []byte) (rom_reader.Bag, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return rom_reader.Bag{}, err
+	}
+
+	return rom_reader.GetBag(game)
+}
+
+func ParsePokedex(savefile []byte) (rom_reader.Pokedex, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return rom_reader.Pokedex{}, err
+	}
+
+	return rom_reader.GetPokedex(game)
+}
+
+// Exports a party pokemon as a 236 byte .pk4 file (gen. 4 only)
+func ExportPartyPokemon(savefile []byte, partyIndex uint) ([]byte, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return rom_reader.ExportPartyPokemon(game, partyIndex)
+}
+
+// Exports a box pokemon as a 136 byte .pk4 file (gen. 4 only)
+func ExportBoxPokemon(savefile []byte, box, slot uint) ([]byte, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return rom_reader.ExportBoxPokemon(game, box, slot)
+}
+
+func ParsePK4(pk4 []byte) (rom_reader.Pokemon, error) {
+	return rom_reader.DecodePK4(pk4)
+}
+
+// Exports a party pokemon as a 236 byte .ek4 file (gen. 4 only)
+func ExportPartyEK4(savefile []byte, partyIndex uint) ([]byte, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return rom_reader.ExportPartyEK4(game, partyIndex)
+}
+
+// Exports a box pokemon as a 136 byte .ek4 file (gen. 4 only)
+func ExportBoxEK4(savefile []byte, box, slot uint) ([]byte, error) {
+	game, _, err := load(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return rom_reader.ExportBoxEK4(game, box, slot)
+}
+
+func ParseEK4(ek4 []byte) (rom_reader.Pokemon, error) {
+	return rom_reader.DecodeEK4(ek4)
+}
+
+// Describes the savefile's blocks, even if they are corrupted
+func ParseInfo(savefile []byte) (sav.SaveInfo, error) {
+	raw, _, err := container.Unwrap(savefile)
+	if err != nil {
+		return sav.SaveInfo{}, err
+	}
+
+	return sav.GetInfo(raw)
+}
+
+// The updated savefile is returned in the same container format it was read from.
+// The same goes for every other write below
 func Write(savefile []byte, newBytes []req.WriteRequest) ([]byte, error) {
-	game, err := sav.Validate(savefile)
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.UpdatePartyPokemon(game, newBytes)
+	})
+}
+
+func WriteTrainer(savefile []byte, newData req.TrainerWriteRequest) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.UpdateTrainer(game, newData)
+	})
+}
+
+func WriteBoxes(savefile []byte, newData []req.BoxWriteRequest) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.UpdateBoxPokemon(game, newData)
+	})
+}
+
+func WriteBoxMetadata(savefile []byte, newData req.BoxesWriteRequest) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.UpdateBoxes(game, newData)
+	})
+}
+
+func WriteBag(savefile []byte, newData *req.BagWriteRequest) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.UpdateBag(game, newData)
+	})
+}
+
+func WritePokedex(savefile []byte, newData *req.PokedexWriteRequest) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.UpdatePokedex(game, newData)
+	})
+}
+
+// plaintext is a decrypted pokemon, of which only the first 136 bytes are stored
+func InsertBoxPokemon(savefile []byte, box, slot uint, plaintext []byte) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.InsertBoxPokemon(game, box, slot, plaintext)
+	})
+}
+
+func ClearBoxSlot(savefile []byte, box, slot uint) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.ClearBoxSlot(game, box, slot)
+	})
+}
+
+func MoveBoxPokemon(savefile []byte, fromBox, fromSlot, toBox, toSlot uint) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.MoveBoxPokemon(game, fromBox, fromSlot, toBox, toSlot)
+	})
+}
+
+func DepositPokemon(savefile []byte, partyIndex, box, slot uint) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.DepositPokemon(game, partyIndex, box, slot)
+	})
+}
+
+func WithdrawPokemon(savefile []byte, box, slot uint) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.WithdrawPokemon(game, box, slot)
+	})
+}
+
+// pk4 is a .pk4 file in either format. An index equal to the party size adds the pokemon to the party
+func ImportPartyPokemon(savefile []byte, partyIndex uint, pk4 []byte) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.ImportPartyPokemon(game, partyIndex, pk4)
+	})
+}
+
+// ek4 is an .ek4 file in either format, imported like ImportPartyPokemon does
+func ImportPartyEK4(savefile []byte, partyIndex uint, ek4 []byte) ([]byte, error) {
+	return update(savefile, func(game sav.ISave) ([]byte, error) {
+		return rom_writer.ImportPartyEK4(game, partyIndex, ek4)
+	})
+}
+
+func Repair(savefile []byte) ([]byte, error) {
+	raw, packaging, err := container.Unwrap(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	repaired, err := sav.Repair(raw)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return packaging.Wrap(repaired), nil
+}
+
+// strips any emulator/flashcart container before validating the raw savefile
+func load(savefile []byte) (sav.ISave, container.Container, error) {
+	raw, packaging, err := container.Unwrap(savefile)
+	if err != nil {
+		return nil, container.Container{}, err
+	}
+
+	game, err := sav.Validate(raw)
+	if err != nil {
+		return nil, container.Container{}, err
+	}
+
+	return game, packaging, nil
+}
+
+// applies the write to the raw savefile, then puts it back in its container
+func update(savefile []byte, write func(game sav.ISave) ([]byte, error)) ([]byte, error) {
+	game, packaging, err := load(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	updated, err := write(game)
 	if err != nil {
 		return []byte{}, err
 	}
 
-	return rom_writer.UpdatePartyPokemon(game, newBytes)
+	return packaging.Wrap(updated), nil
 }
@@ -0,0 +1,162 @@
+package pid
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+)
+
+// shiny when the TID, SID and both halves of the personality value XOR to less than 8
+func IsShiny(personality uint32, tid, sid uint16) bool {
+	return shinyValue(personality, tid, sid) < 8
+}
+
+func shinyValue(personality uint32, tid, sid uint16) uint16 {
+	return tid ^ sid ^ uint16(personality>>16) ^ uint16(personality)
+}
+
+// gender implied by the low byte of the personality value, given the species' gender ratio
+func Gender(personality uint32, ratio uint8) string {
+	switch ratio {
+	case data.GENDERLESS:
+		return "Genderless"
+	case data.FEMALE_ONLY:
+		return "Female"
+	case data.MALE_ONLY:
+		return "Male"
+	}
+
+	if uint8(personality) < ratio {
+		return "Female"
+	}
+
+	return "Male"
+}
+
+// the lowest bit picks between the species' 2 abilities in gen 4 games
+func AbilitySlot(personality uint32) uint {
+	return uint(personality & 1)
+}
+
+/*
+Finds a shiny personality value for the given OT that behaves like the original one:
+  - personality % 25 is unchanged, so gen 4 natures are kept
+  - the low byte is unchanged, so the gender and the gen 4 ability slot are kept
+  - bit 16 is unchanged, so the gen 5 ability slot is kept
+
+The original personality value is returned as-is if it's already shiny
+*/
+func MakeShiny(personality uint32, tid, sid uint16) (uint32, error) {
+	if IsShiny(personality, tid, sid) {
+		return personality, nil
+	}
+
+	return findShiny(personality, tid, sid, uint(personality%25), sameLowByte(personality))
+}
+
+/*
+Finds a personality value with the given gen 4 nature index that keeps the gender and
+ability slots of the original one, like MakeShiny does. When keepShiny is set, the new
+personality value is shiny only if the original one is. Otherwise shininess may change
+*/
+func WithNature(personality uint32, nature uint, tid, sid uint16, keepShiny bool) (uint32, error) {
+	if nature >= 25 {
+		return 0, fmt.Errorf("invalid nature index %d", nature)
+	}
+
+	if uint(personality%25) == nature {
+		return personality, nil
+	}
+
+	return search(personality, tid, sid, keepShiny, nature, sameLowByte(personality))
+}
+
+// Finds a personality value with the given gender that keeps the nature, ability slot and
+// bit 16 of the original one. keepShiny works like it does in WithNature
+func WithGender(personality uint32, ratio uint8, gender string, tid, sid uint16, keepShiny bool) (uint32, error) {
+	if Gender(personality, ratio) == gender {
+		return personality, nil
+	}
+
+	lowByteOk := func(low uint32) bool {
+		return Gender(low, ratio) == gender && AbilitySlot(low) == AbilitySlot(personality)
+	}
+
+	return search(personality, tid, sid, keepShiny, uint(personality%25), lowByteOk)
+}
+
+// Finds a personality value with the given gen 4 ability slot that keeps the nature, gender
+// and bit 16 of the original one. keepShiny works like it does in WithNature
+func WithAbilitySlot(personality uint32, ratio uint8, slot uint, tid, sid uint16, keepShiny bool) (uint32, error) {
+	if slot > 1 {
+		return 0, fmt.Errorf("invalid ability slot %d", slot)
+	}
+
+	if AbilitySlot(personality) == slot {
+		return personality, nil
+	}
+
+	lowByteOk := func(low uint32) bool {
+		return Gender(low, ratio) == Gender(personality, ratio) && AbilitySlot(low) == slot
+	}
+
+	return search(personality, tid, sid, keepShiny, uint(personality%25), lowByteOk)
+}
+
+func sameLowByte(personality uint32) func(uint32) bool {
+	return func(low uint32) bool {
+		return low == personality&0xFF
+	}
+}
+
+// searches for a personality value with the given nature whose low byte is accepted by
+// lowByteOk. Bit 16 is always kept, and shininess too if keepShiny is set
+func search(personality uint32, tid, sid uint16, keepShiny bool, nature uint, lowByteOk func(uint32) bool) (uint32, error) {
+	shiny := IsShiny(personality, tid, sid)
+	if keepShiny && shiny {
+		return findShiny(personality, tid, sid, nature, lowByteOk)
+	}
+
+	highHalf := personality >> 16
+
+	// low halves are tried starting from the original one. 2 * 0x10000 and 25 are coprime,
+	// so 25 high halves sharing bit 16 cover every nature, unless they wrap around
+	for offset := uint32(0); offset <= 0xFFFF; offset++ {
+		lowHalf := (personality + offset) & 0xFFFF
+		if !lowByteOk(lowHalf & 0xFF) {
+			continue
+		}
+
+		for i := uint32(0); i < 25; i++ {
+			candidate := ((highHalf+2*i)&0xFFFF)<<16 | lowHalf
+
+			if uint(candidate%25) == nature && !(keepShiny && IsShiny(candidate, tid, sid)) {
+				return candidate, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("no personality value with nature index %d keeps the requested traits of 0x%08X", nature, personality)
+}
+
+// shiny personality values sharing bit 16 of the original one, whose low byte is accepted by lowByteOk
+func findShiny(personality uint32, tid, sid uint16, nature uint, lowByteOk func(uint32) bool) (uint32, error) {
+	// the high half is fully determined by the low half and the shiny value, so only the
+	// low half and the 3 bits of leeway in the shiny value are searched
+	for lowHalf := uint32(0); lowHalf <= 0xFFFF; lowHalf++ {
+		if !lowByteOk(lowHalf & 0xFF) {
+			continue
+		}
+
+		for leeway := uint16(0); leeway < 8; leeway++ {
+			highHalf := uint32(tid ^ sid ^ uint16(lowHalf) ^ leeway)
+			candidate := highHalf<<16 | lowHalf
+
+			if uint(candidate%25) == nature && highHalf&1 == (personality>>16)&1 {
+				return candidate, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("no shiny personality value with nature index %d keeps the requested traits of 0x%08X", nature, personality)
+}
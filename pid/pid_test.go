@@ -0,0 +1,149 @@
+package pid
+
+import "testing"
+
+func TestIsShiny(t *testing.T) {
+	// TID ^ SID ^ high ^ low == 7
+	if !IsShiny(0x00000007, 0, 0) {
+		t.Fatal("expected personality value to be shiny")
+	}
+
+	if IsShiny(0x00000008, 0, 0) {
+		t.Fatal("expected personality value not to be shiny")
+	}
+
+	if IsShiny(0x94DFB7DB, 26241, 11961) {
+		t.Fatal("expected mock weavile not to be shiny")
+	}
+}
+
+func TestMakeShiny(t *testing.T) {
+	var personality uint32 = 0x94DFB7DB
+	var tid, sid uint16 = 26241, 11961
+
+	shiny, err := MakeShiny(personality, tid, sid)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if !IsShiny(shiny, tid, sid) {
+		t.Fatalf("expected 0x%08X to be shiny\n", shiny)
+	}
+
+	if shiny%25 != personality%25 {
+		t.Fatalf("expected nature %d, but got %d\n", personality%25, shiny%25)
+	}
+
+	if shiny&0xFF != personality&0xFF {
+		t.Fatalf("expected low byte 0x%02X, but got 0x%02X\n", personality&0xFF, shiny&0xFF)
+	}
+
+	if shiny&0x10000 != personality&0x10000 {
+		t.Fatal("expected gen 5 ability bit to be kept")
+	}
+
+	same, err := MakeShiny(shiny, tid, sid)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if same != shiny {
+		t.Fatalf("expected 0x%08X, but got 0x%08X\n", shiny, same)
+	}
+}
+
+func TestWithNature(t *testing.T) {
+	var personality uint32 = 0x94DFB7DB
+	var tid, sid uint16 = 26241, 11961
+
+	shiny, err := MakeShiny(personality, tid, sid)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	for _, original := range []uint32{personality, shiny} {
+		for nature := uint(0); nature < 25; nature++ {
+			res, err := WithNature(original, nature, tid, sid, true)
+			if err != nil {
+				t.Fatal("Unexpected error ", err)
+			}
+
+			if uint(res%25) != nature {
+				t.Fatalf("expected nature %d, but got %d\n", nature, res%25)
+			}
+
+			if res&0x100FF != original&0x100FF {
+				t.Fatalf("expected 0x%08X to keep the gender and ability of 0x%08X\n", res, original)
+			}
+
+			if IsShiny(res, tid, sid) != IsShiny(original, tid, sid) {
+				t.Fatalf("expected 0x%08X to keep the shininess of 0x%08X\n", res, original)
+			}
+		}
+	}
+
+	if _, err := WithNature(personality, 25, tid, sid, false); err == nil {
+		t.Fatal("expected nature index 25 to be rejected")
+	}
+}
+
+func TestGender(t *testing.T) {
+	cases := []struct {
+		personality uint32
+		ratio       uint8
+		expected    string
+	}{
+		{0x8C64BA79, 127, "Female"},
+		{0x31A6A585, 127, "Male"},
+		{0x31A6A500, 0, "Male"},
+		{0x31A6A5FF, 254, "Female"},
+		{0x31A6A500, 255, "Genderless"},
+	}
+
+	for _, c := range cases {
+		if res := Gender(c.personality, c.ratio); res != c.expected {
+			t.Fatalf("expected %s, but got %s\n", c.expected, res)
+		}
+	}
+}
+
+func TestWithGenderAndAbilitySlot(t *testing.T) {
+	var personality uint32 = 0x8C64BA79 // female, second ability
+	var tid, sid uint16 = 26241, 11961
+
+	male, err := WithGender(personality, 127, "Male", tid, sid, true)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if Gender(male, 127) != "Male" || AbilitySlot(male) != 1 || male%25 != personality%25 || IsShiny(male, tid, sid) {
+		t.Fatalf("expected 0x%08X to only change the gender of 0x%08X\n", male, personality)
+	}
+
+	first, err := WithAbilitySlot(personality, 127, 0, tid, sid, true)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if Gender(first, 127) != "Female" || AbilitySlot(first) != 0 || first%25 != personality%25 || IsShiny(first, tid, sid) {
+		t.Fatalf("expected 0x%08X to only change the ability slot of 0x%08X\n", first, personality)
+	}
+
+	shiny, err := MakeShiny(personality, tid, sid)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	shinyMale, err := WithGender(shiny, 127, "Male", tid, sid, true)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if Gender(shinyMale, 127) != "Male" || !IsShiny(shinyMale, tid, sid) {
+		t.Fatalf("expected 0x%08X to be a shiny male\n", shinyMale)
+	}
+
+	if _, err := WithAbilitySlot(personality, 127, 2, tid, sid, true); err == nil {
+		t.Fatal("expected ability slot 2 to be rejected")
+	}
+}
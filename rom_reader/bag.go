@@ -0,0 +1,53 @@
+package rom_reader
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+type BagItem struct {
+	Id       uint16
+	Name     string
+	Quantity uint
+}
+
+// Empty slots are left out. Every pocket is present, even if it holds no items
+type Bag map[pocket.Pocket][]BagItem
+
+func GetBag(game sav.ISave) (Bag, error) {
+	gen4, ok := game.(sav.IGen4Save)
+	if !ok {
+		return Bag{}, fmt.Errorf("bag data is only supported in gen 4 savefiles")
+	}
+
+	section := gen4.BagSection()
+	bag := make(Bag)
+
+	for _, p := range gen4.BagPockets() {
+		items := make([]BagItem, 0)
+
+		for slot := uint(0); slot < p.Slots; slot++ {
+			offset := p.Offset + slot*sav.BAG_SLOT_SIZE
+			id := binary.LittleEndian.Uint16(section[offset : offset+2])
+			quantity := binary.LittleEndian.Uint16(section[offset+2 : offset+4])
+			if id == 0 {
+				continue
+			}
+
+			item, err := data.GetItem(id)
+			if err != nil {
+				return Bag{}, fmt.Errorf("invalid item in the %s pocket: %w", p.Pocket, err)
+			}
+
+			items = append(items, BagItem{id, item.Name, uint(quantity)})
+		}
+
+		bag[p.Pocket] = items
+	}
+
+	return bag, nil
+}
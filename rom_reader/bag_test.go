@@ -0,0 +1,56 @@
+package rom_reader
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func TestGetBag(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	bag, err := GetBag(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(bag) != 8 {
+		t.Fatalf("expected %d pockets, but got %d\n", 8, len(bag))
+	}
+
+	if len(bag[pocket.MAIL]) != 0 {
+		t.Fatalf("expected an empty mail pocket, but got %+v\n", bag[pocket.MAIL])
+	}
+
+	expected := map[pocket.Pocket]BagItem{
+		pocket.ITEMS:        {77, "Max Repel", 20},
+		pocket.MEDICINE:     {54, "Old Gateau", 1},
+		pocket.POKE_BALLS:   {13, "Dusk Ball", 1},
+		pocket.TMS_HMS:      {332, "TM05", 1},
+		pocket.BERRIES:      {149, "Cheri Berry", 1},
+		pocket.BATTLE_ITEMS: {57, "X Attack", 1},
+		pocket.KEY_ITEMS:    {433, "Journal", 1},
+	}
+
+	for p, item := range expected {
+		if bag[p][0] != item {
+			t.Fatalf("%s: expected %+v, but got %+v\n", p, item, bag[p][0])
+		}
+	}
+
+	// the Vs. Recorder comes right after the SecretPotion in the item table
+	keyItems := bag[pocket.KEY_ITEMS]
+	if keyItems[1] != (BagItem{465, "Vs. Recorder", 1}) {
+		t.Fatalf("expected Vs. Recorder, but got %+v\n", keyItems[1])
+	}
+}
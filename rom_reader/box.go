@@ -0,0 +1,69 @@
+package rom_reader
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+// Box and Slot are 0-indexed. Slots are numbered left to right, top to bottom
+type BoxPokemon struct {
+	Box  uint
+	Slot uint
+	Pokemon
+}
+
+// Only occupied slots are returned, ordered by box then slot
+func GetBoxPokemon(game sav.ISave) ([]BoxPokemon, error) {
+	gen4, ok := game.(sav.IGen4Save)
+	if !ok {
+		return []BoxPokemon{}, fmt.Errorf("box data is only supported in gen 4 savefiles")
+	}
+
+	boxes := gen4.BoxSection()
+	var pokemon []BoxPokemon
+
+	for box := uint(0); box < consts.BOX_COUNT; box++ {
+		for slot := uint(0); slot < consts.BOX_SLOTS; slot++ {
+			offset := box*gen4.BoxSize() + slot*consts.BOX_POKEMON_SIZE
+			if sav.IsEmptySlot(boxes[offset:]) {
+				continue
+			}
+
+			parsed, err := parseBoxPokemon(boxes[offset:])
+			if err != nil {
+				return []BoxPokemon{}, fmt.Errorf("box %d slot %d: %s", box, slot, err)
+			}
+
+			pokemon = append(pokemon, BoxPokemon{box, slot, parsed})
+		}
+	}
+
+	return pokemon, nil
+}
+
+// box pokemon don't store battle stats; they are computed when the pokemon is withdrawn
+func parseBoxPokemon(ciphertext []byte) (Pokemon, error) {
+	plaintext, err := crypt.Decrypt(ciphertext, consts.BOX_POKEMON_SIZE)
+	if err != nil {
+		return Pokemon{}, err
+	}
+
+	return decodeBoxPokemon(plaintext)
+}
+
+// plaintext only needs the first 136 bytes
+func decodeBoxPokemon(plaintext []byte) (Pokemon, error) {
+	buffer := make([]byte, consts.PARTY_POKEMON_SIZE)
+	copy(buffer, plaintext[:consts.BOX_POKEMON_SIZE])
+
+	pokemon, err := decodePokemon(buffer, false)
+	if err != nil {
+		return Pokemon{}, err
+	}
+
+	pokemon.BattleStat = BattleStat{}
+	return pokemon, nil
+}
@@ -0,0 +1,82 @@
+package rom_reader
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func TestGetBoxPokemon(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	boxPokemon, err := GetBoxPokemon(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(boxPokemon) != 49 {
+		t.Fatalf("expected %d box pokemon, but got %d\n", 49, len(boxPokemon))
+	}
+
+	expected := []struct {
+		box, slot uint
+		name      string
+		dexId     uint16
+		species   string
+		nature    string
+	}{
+		{0, 0, "SHINX", 403, "Shinx", "Relaxed"},
+		{0, 1, "PSYDUCK", 54, "Psyduck", "Hasty"},
+		{0, 2, "STARLY", 396, "Starly", "Timid"},
+	}
+
+	for i, e := range expected {
+		p := boxPokemon[i]
+		if p.Box != e.box || p.Slot != e.slot || p.Name != e.name || p.PokedexId != e.dexId || p.Species != e.species || p.Nature != e.nature {
+			t.Fatalf("expected %+v, but got %+v\n", e, p)
+		}
+
+		if p.BattleStat != (BattleStat{}) {
+			t.Fatalf("expected box pokemon to have no battle stats, but got %+v\n", p.BattleStat)
+		}
+	}
+}
+
+func TestGetBoxes(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	boxes, err := GetBoxes(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	// default box names, and wallpapers cycling through the regular ones
+	for i, box := range boxes.Boxes {
+		expected := Box{fmt.Sprintf("BOX %d", i+1), uint(i % 16)}
+		if box != expected {
+			t.Fatalf("expected %+v, but got %+v\n", expected, box)
+		}
+	}
+
+	if boxes.UnlockedWallpapers != [8]bool{} {
+		t.Fatalf("expected no special wallpapers to be unlocked, but got %v\n", boxes.UnlockedWallpapers)
+	}
+}
@@ -0,0 +1,41 @@
+package rom_reader
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+type Box struct {
+	Name      string
+	Wallpaper uint
+}
+
+// Wallpapers 0-15 are always available. Special wallpapers (16-23) can only be
+// used once unlocked, UnlockedWallpapers[0] being wallpaper 16
+type Boxes struct {
+	Boxes              [consts.BOX_COUNT]Box
+	UnlockedWallpapers [consts.SPECIAL_WALLPAPERS]bool
+}
+
+func GetBoxes(game sav.ISave) (Boxes, error) {
+	gen4, ok := game.(sav.IGen4Save)
+	if !ok {
+		return Boxes{}, fmt.Errorf("box data is only supported in gen 4 savefiles")
+	}
+
+	section := gen4.BoxMetadataSection()
+	var boxes Boxes
+
+	for i := range boxes.Boxes {
+		name := section[i*consts.BOX_NAME_SIZE : (i+1)*consts.BOX_NAME_SIZE]
+		boxes.Boxes[i] = Box{
+			decodeName(name),
+			uint(section[consts.BOX_WALLPAPERS+i]),
+		}
+	}
+
+	boxes.UnlockedWallpapers = decodeFlags(section[consts.BOX_WALLPAPER_FLAGS])
+	return boxes, nil
+}
@@ -0,0 +1,58 @@
+package rom_reader
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+/*
+.ek4 files hold a single pokemon exactly as the games store it: encrypted, with its blocks
+in personality value order. They come in the same 136 and 236 byte formats as .pk4 files
+*/
+
+// Exports the party pokemon at the given index as a 236 byte .ek4 file
+func ExportPartyEK4(game sav.ISave, partyIndex uint) ([]byte, error) {
+	ciphertext, err := partySlot(game, partyIndex)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return exportEncrypted(ciphertext)
+}
+
+// Exports the pokemon in the given box slot as a 136 byte .ek4 file
+func ExportBoxEK4(game sav.ISave, box, slot uint) ([]byte, error) {
+	ciphertext, err := boxSlot(game, box, slot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return exportEncrypted(ciphertext)
+}
+
+// the pokemon is decrypted first, so corrupted pokemon aren't exported
+func exportEncrypted(ciphertext []byte) ([]byte, error) {
+	if _, err := crypt.Decrypt(ciphertext, len(ciphertext)); err != nil {
+		return []byte{}, err
+	}
+
+	ek4 := make([]byte, len(ciphertext))
+	copy(ek4, ciphertext)
+	return ek4, nil
+}
+
+// Decodes an .ek4 file, in either format. Box format files have no battle stats
+func DecodeEK4(ek4 []byte) (Pokemon, error) {
+	if err := checkFileSize(ek4); err != nil {
+		return Pokemon{}, err
+	}
+
+	plaintext, err := crypt.Decrypt(ek4, len(ek4))
+	if err != nil {
+		return Pokemon{}, fmt.Errorf("invalid .ek4 file: %s", err)
+	}
+
+	return decodeFile(plaintext)
+}
@@ -0,0 +1,70 @@
+package rom_reader
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExportPartyEK4(t *testing.T) {
+	game := readMockSave(t)
+
+	ek4, err := ExportPartyEK4(game, 0)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if !cmp.Equal(ek4, game.PartySection()[:236]) {
+		t.Fatal("expected the .ek4 file to match the encrypted party pokemon")
+	}
+
+	decoded, err := DecodeEK4(ek4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	party, err := GetPartyPokemon(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if diff := cmp.Diff(party[0], decoded); diff != "" {
+		t.Fatalf("expected the exported pokemon to decode like the party one (-want +got):\n%s", diff)
+	}
+}
+
+func TestExportBoxEK4(t *testing.T) {
+	game := readMockSave(t)
+
+	ek4, err := ExportBoxEK4(game, 0, 1)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(ek4) != 136 {
+		t.Fatalf("expected %d bytes, but got %d\n", 136, len(ek4))
+	}
+
+	decoded, err := DecodeEK4(ek4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if decoded.Species != "Psyduck" || decoded.BattleStat != (BattleStat{}) {
+		t.Fatalf("expected a Psyduck without battle stats, but got %+v\n", decoded)
+	}
+}
+
+func TestDecodeEK4Validation(t *testing.T) {
+	game := readMockSave(t)
+	ek4, _ := ExportBoxEK4(game, 0, 1)
+
+	ek4[0x20] ^= 0xFF
+	if _, err := DecodeEK4(ek4); err == nil {
+		t.Fatal("expected a corrupted .ek4 file to be rejected")
+	}
+
+	if _, err := DecodeEK4(ek4[:100]); err == nil {
+		t.Fatal("expected a 100 byte file to be rejected")
+	}
+}
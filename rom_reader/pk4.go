@@ -0,0 +1,152 @@
+package rom_reader
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+)
+
+/*
+.pk4 files hold a single decrypted pokemon, with its blocks in ABCD order regardless of
+the personality value. Box pokemon are exported in the 136 byte format, and party pokemon
+in the 236 byte one, which keeps their level, battle stats and status
+*/
+
+// Exports the party pokemon at the given index as a 236 byte .pk4 file
+func ExportPartyPokemon(game sav.ISave, partyIndex uint) ([]byte, error) {
+	ciphertext, err := partySlot(game, partyIndex)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return exportPokemon(ciphertext, consts.PARTY_POKEMON_SIZE)
+}
+
+// Exports the pokemon in the given box slot as a 136 byte .pk4 file
+func ExportBoxPokemon(game sav.ISave, box, slot uint) ([]byte, error) {
+	ciphertext, err := boxSlot(game, box, slot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return exportPokemon(ciphertext, consts.BOX_POKEMON_SIZE)
+}
+
+// the encrypted party pokemon, as stored in the savefile
+func partySlot(game sav.ISave, partyIndex uint) ([]byte, error) {
+	if _, ok := game.(sav.IGen4Save); !ok {
+		return []byte{}, fmt.Errorf(".pk4/.ek4 files are only supported in gen 4 savefiles")
+	}
+
+	if partyIndex >= uint(game.PartySize()) {
+		return []byte{}, fmt.Errorf("invalid party index %d", partyIndex)
+	}
+
+	offset := partyIndex * consts.PARTY_POKEMON_SIZE
+	return game.PartySection()[offset : offset+consts.PARTY_POKEMON_SIZE], nil
+}
+
+// the encrypted box pokemon, as stored in the savefile
+func boxSlot(game sav.ISave, box, slot uint) ([]byte, error) {
+	gen4, ok := game.(sav.IGen4Save)
+	if !ok {
+		return []byte{}, fmt.Errorf(".pk4/.ek4 files are only supported in gen 4 savefiles")
+	}
+
+	if box >= consts.BOX_COUNT || slot >= consts.BOX_SLOTS {
+		return []byte{}, fmt.Errorf("invalid box %d slot %d", box, slot)
+	}
+
+	boxes := gen4.BoxSection()
+	offset := box*gen4.BoxSize() + slot*consts.BOX_POKEMON_SIZE
+	if sav.IsEmptySlot(boxes[offset:]) {
+		return []byte{}, fmt.Errorf("box %d slot %d is empty", box, slot)
+	}
+
+	return boxes[offset : offset+consts.BOX_POKEMON_SIZE], nil
+}
+
+func exportPokemon(ciphertext []byte, size int) ([]byte, error) {
+	plaintext, err := crypt.Decrypt(ciphertext, size)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	pk4, err := shuffler.Unshuffle(plaintext, personality)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return pk4[:size], nil
+}
+
+// Decodes a .pk4 file, in either format. Box format files have no battle stats
+func DecodePK4(pk4 []byte) (Pokemon, error) {
+	if err := CheckPK4(pk4); err != nil {
+		return Pokemon{}, err
+	}
+
+	plaintext, err := shuffler.Shuffle(pk4, binary.LittleEndian.Uint32(pk4[0:4]))
+	if err != nil {
+		return Pokemon{}, err
+	}
+
+	return decodeFile(plaintext)
+}
+
+// Checks that a .pk4 file holds a gen 4 pokemon that wasn't damaged or tampered with: the
+// stored checksum must match blocks A to D, and the species, held item and ability must exist
+func CheckPK4(pk4 []byte) error {
+	if err := checkFileSize(pk4); err != nil {
+		return err
+	}
+
+	stored := binary.LittleEndian.Uint16(pk4[0x6:0x8])
+	if checksum := crypt.Checksum(pk4); checksum != stored {
+		return fmt.Errorf("invalid .pk4 file: checksum invalid. expected 0x%x, got 0x%x", stored, checksum)
+	}
+
+	// blocks are in ABCD order, so block A comes first
+	blockA := pk4[0x8 : 0x8+consts.BLOCK_SIZE_BYTES]
+
+	dexId := binary.LittleEndian.Uint16(blockA[0:2])
+	if _, err := data.GetSpecies(dexId); err != nil {
+		return fmt.Errorf("invalid .pk4 file: invalid species %d", dexId)
+	}
+
+	itemId := binary.LittleEndian.Uint16(blockA[consts.BLOCK_A_ITEM:])
+	if _, err := data.GetItem(itemId); err != nil {
+		return fmt.Errorf("invalid .pk4 file: invalid held item %d", itemId)
+	}
+
+	if _, err := data.GetAbility(uint(blockA[consts.BLOCK_A_ABILITY])); err != nil {
+		return fmt.Errorf("invalid .pk4 file: invalid ability %d", blockA[consts.BLOCK_A_ABILITY])
+	}
+
+	return nil
+}
+
+// .pk4 and .ek4 files come in the box and gen 4 party formats
+func checkFileSize(file []byte) error {
+	if len(file) != consts.BOX_POKEMON_SIZE && len(file) != consts.PARTY_POKEMON_SIZE {
+		return fmt.Errorf("expected a %d or %d byte file, got %d bytes", consts.BOX_POKEMON_SIZE, consts.PARTY_POKEMON_SIZE, len(file))
+	}
+
+	return nil
+}
+
+// plaintext is in personality value order
+func decodeFile(plaintext []byte) (Pokemon, error) {
+	if len(plaintext) == consts.BOX_POKEMON_SIZE {
+		return decodeBoxPokemon(plaintext)
+	}
+
+	return decodePokemon(plaintext, false)
+}
@@ -0,0 +1,157 @@
+package rom_reader
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/google/go-cmp/cmp"
+)
+
+func readMockSave(t *testing.T) sav.ISave {
+	savefile, err := os.ReadFile("./mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	return game
+}
+
+func TestExportPartyPokemon(t *testing.T) {
+	game := readMockSave(t)
+
+	pk4, err := ExportPartyPokemon(game, 0)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(pk4) != 236 {
+		t.Fatalf("expected %d bytes, but got %d\n", 236, len(pk4))
+	}
+
+	// block A always comes first, starting with the species
+	if dexId := binary.LittleEndian.Uint16(pk4[8:10]); dexId != 461 {
+		t.Fatalf("expected %d, but got %d\n", 461, dexId)
+	}
+
+	checksum := uint16(0)
+	for i := 0x8; i < 0x88; i += 2 {
+		checksum += binary.LittleEndian.Uint16(pk4[i:])
+	}
+
+	if expected := binary.LittleEndian.Uint16(pk4[6:8]); checksum != expected {
+		t.Fatalf("expected checksum 0x%x, but got 0x%x\n", expected, checksum)
+	}
+
+	decoded, err := DecodePK4(pk4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	party, err := GetPartyPokemon(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if diff := cmp.Diff(party[0], decoded); diff != "" {
+		t.Fatalf("expected the exported pokemon to decode like the party one (-want +got):\n%s", diff)
+	}
+}
+
+func TestExportBoxPokemon(t *testing.T) {
+	game := readMockSave(t)
+
+	pk4, err := ExportBoxPokemon(game, 0, 1)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(pk4) != 136 {
+		t.Fatalf("expected %d bytes, but got %d\n", 136, len(pk4))
+	}
+
+	decoded, err := DecodePK4(pk4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	boxPokemon, err := GetBoxPokemon(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if diff := cmp.Diff(boxPokemon[1].Pokemon, decoded); diff != "" {
+		t.Fatalf("expected the exported pokemon to decode like the box one (-want +got):\n%s", diff)
+	}
+}
+
+func TestExportValidation(t *testing.T) {
+	game := readMockSave(t)
+
+	if _, err := ExportPartyPokemon(game, 6); err == nil {
+		t.Fatal("expected party index 6 to be rejected")
+	}
+
+	if _, err := ExportBoxPokemon(game, 17, 29); err == nil {
+		t.Fatal("expected an empty box slot to be rejected")
+	}
+
+	if _, err := DecodePK4(make([]byte, 220)); err == nil {
+		t.Fatal("expected a 220 byte file to be rejected")
+	}
+}
+
+// corrupted copies of the given .pk4 file, which must all be rejected
+func corruptPK4(pk4 []byte) map[string][]byte {
+	// the checksum is fixed up, so that only the range checks can catch these
+	withChecksum := func(offset int, value uint16) []byte {
+		corrupted := make([]byte, len(pk4))
+		copy(corrupted, pk4)
+
+		old := binary.LittleEndian.Uint16(corrupted[offset:])
+		binary.LittleEndian.PutUint16(corrupted[offset:], value)
+		checksum := binary.LittleEndian.Uint16(corrupted[6:]) - old + value
+		binary.LittleEndian.PutUint16(corrupted[6:], checksum)
+		return corrupted
+	}
+
+	flipped := make([]byte, len(pk4))
+	copy(flipped, pk4)
+	flipped[0x40] ^= 0xFF
+
+	// block A starts at 0x8, so the ability shares a word with the friendship
+	friendship := uint16(pk4[0x8+0xC])
+
+	return map[string][]byte{
+		"checksum": flipped,
+		"species":  withChecksum(0x8, 600),
+		"item":     withChecksum(0x8+0x2, 0xFFFF),
+		"ability":  withChecksum(0x8+0xC, 200<<8|friendship),
+	}
+}
+
+func TestDecodeCorruptedPK4(t *testing.T) {
+	game := readMockSave(t)
+
+	for _, export := range []func() ([]byte, error){
+		func() ([]byte, error) { return ExportPartyPokemon(game, 0) },
+		func() ([]byte, error) { return ExportBoxPokemon(game, 0, 1) },
+	} {
+		pk4, err := export()
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		for field, corrupted := range corruptPK4(pk4) {
+			if _, err := DecodePK4(corrupted); err == nil {
+				t.Fatalf("expected a .pk4 file with a bad %s to be rejected\n", field)
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package rom_reader
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/char"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/pid"
+)
+
+// PP is the current PP, out of the base PP raised by PPUps
+type Move struct {
+	Id    uint16
+	Name  string
+	PP    uint
+	PPUps uint
+}
+
+type OriginalTrainer struct {
+	Name   string
+	TID    uint16
+	SID    uint16
+	Gender string
+}
+
+// the zero value means the date was never set (eg. the pokemon never was an egg)
+type Date struct {
+	Year  uint
+	Month uint
+	Day   uint
+}
+
+type MetData struct {
+	Location    uint16
+	EggLocation uint16
+	Level       uint
+	Date        Date
+	EggDate     Date
+}
+
+type Pokerus struct {
+	Strain uint
+	Days   uint
+}
+
+type ContestStats struct {
+	Cool   uint
+	Beauty uint
+	Cute   uint
+	Smart  uint
+	Tough  uint
+	Sheen  uint
+}
+
+// decodes everything past the fields shared by party and box summaries
+func decodeDetails(pokemon *Pokemon, blockA, blockB, blockC, blockD []byte, gen5 bool) {
+	for i := 0; i < 4; i++ {
+		moveId := binary.LittleEndian.Uint16(blockB[consts.BLOCK_B_MOVES+i*2:])
+		move, err := data.GetMove(moveId)
+		if err != nil {
+			// moves introduced in gen 5 are missing from the gen 4 move table
+			move.Name = fmt.Sprintf("Unknown (#%d)", moveId)
+		}
+
+		pokemon.Moves[i] = Move{
+			moveId,
+			move.Name,
+			uint(blockB[consts.BLOCK_B_PP+i]),
+			uint(blockB[consts.BLOCK_B_PP_UPS+i]),
+		}
+	}
+
+	otGender := "Male"
+	if blockD[consts.BLOCK_D_MET_LEVEL]&0x80 != 0 {
+		otGender = "Female"
+	}
+
+	pokemon.OT = OriginalTrainer{
+		decodeText(blockD[consts.BLOCK_D_OT_NAME:consts.BLOCK_D_OT_NAME+consts.TRAINER_NAME_SIZE], gen5),
+		binary.LittleEndian.Uint16(blockA[consts.BLOCK_A_OT_ID:]),
+		binary.LittleEndian.Uint16(blockA[consts.BLOCK_A_OT_SECRET_ID:]),
+		otGender,
+	}
+
+	pokemon.IsShiny = pid.IsShiny(pokemon.Personality, pokemon.OT.TID, pokemon.OT.SID)
+	pokemon.Experience = uint(binary.LittleEndian.Uint32(blockA[consts.BLOCK_A_EXPERIENCE:]))
+	pokemon.Friendship = uint(blockA[consts.BLOCK_A_FRIENDSHIP])
+
+	// DP (and gen 5) only use block D's locations. Pt/HGSS store the ones DP can't
+	// display in block B, leaving a placeholder in block D
+	eggLocation := binary.LittleEndian.Uint16(blockD[consts.BLOCK_D_EGG_LOCATION:])
+	metLocation := binary.LittleEndian.Uint16(blockD[consts.BLOCK_D_MET_LOCATION:])
+	if !gen5 {
+		if loc := binary.LittleEndian.Uint16(blockB[consts.BLOCK_B_EGG_LOCATION_PT:]); loc != 0 {
+			eggLocation = loc
+		}
+		if loc := binary.LittleEndian.Uint16(blockB[consts.BLOCK_B_MET_LOCATION_PT:]); loc != 0 {
+			metLocation = loc
+		}
+	}
+
+	pokemon.Met = MetData{
+		metLocation,
+		eggLocation,
+		uint(blockD[consts.BLOCK_D_MET_LEVEL] & 0x7F),
+		decodeDate(blockD[consts.BLOCK_D_MET_DATE:]),
+		decodeDate(blockD[consts.BLOCK_D_EGG_DATE:]),
+	}
+
+	// balls only found in HGSS are stored separately, so DP/Pt can fall back on a regular one
+	ballId := blockD[consts.BLOCK_D_BALL]
+	if !gen5 && blockD[consts.BLOCK_D_BALL_HGSS] != 0 {
+		ballId = blockD[consts.BLOCK_D_BALL_HGSS]
+	}
+
+	pokemon.Ball = lookup(data.GetBall, ballId)
+	pokemon.OriginGame = lookup(data.GetGame, blockC[consts.BLOCK_C_ORIGIN_GAME])
+	pokemon.Language = lookup(data.GetLanguage, blockA[consts.BLOCK_A_LANGUAGE])
+
+	for i := range pokemon.Markings {
+		pokemon.Markings[i] = blockA[consts.BLOCK_A_MARKINGS]&(1<<i) != 0
+	}
+
+	ivBytes := binary.LittleEndian.Uint32(blockB[consts.BLOCK_B_IV:])
+	pokemon.IsEgg = ivBytes&(1<<30) != 0
+	pokemon.IsNicknamed = ivBytes&(1<<31) != 0
+
+	flags := blockB[consts.BLOCK_B_FLAGS]
+	pokemon.FatefulEncounter = flags&0b1 != 0
+	pokemon.Gender = "Male"
+	if flags&0b100 != 0 {
+		pokemon.Gender = "Genderless"
+	} else if flags&0b10 != 0 {
+		pokemon.Gender = "Female"
+	}
+	pokemon.Form = uint(flags >> 3)
+
+	pokerus := blockD[consts.BLOCK_D_POKERUS]
+	pokemon.Pokerus = Pokerus{uint(pokerus >> 4), uint(pokerus & 0xF)}
+
+	contest := blockA[consts.BLOCK_A_CONTEST:]
+	pokemon.ContestStats = ContestStats{
+		uint(contest[0]),
+		uint(contest[1]),
+		uint(contest[2]),
+		uint(contest[3]),
+		uint(contest[4]),
+		uint(contest[5]),
+	}
+
+	pokemon.Ribbons = data.GetRibbons(
+		binary.LittleEndian.Uint32(blockA[consts.BLOCK_A_RIBBONS_SINNOH:]),
+		binary.LittleEndian.Uint32(blockB[consts.BLOCK_B_RIBBONS_HOENN:]),
+		binary.LittleEndian.Uint32(blockC[consts.BLOCK_C_RIBBONS_SINNOH_2:]),
+	)
+}
+
+// dates are stored as (year - 2000, month, day)
+func decodeDate(buf []byte) Date {
+	if buf[0] == 0 && buf[1] == 0 && buf[2] == 0 {
+		return Date{}
+	}
+
+	return Date{uint(buf[0]) + 2000, uint(buf[1]), uint(buf[2])}
+}
+
+// text runs until the terminator, or the end of the buffer
+func decodeText(buf []byte, gen5 bool) string {
+	decodeChar := char.Char
+	if gen5 {
+		decodeChar = char.CharGen5
+	}
+
+	text := ""
+	for i := 0; i+1 < len(buf); i += 2 {
+		str, err := decodeChar(binary.LittleEndian.Uint16(buf[i : i+2]))
+		if err != nil {
+			break
+		}
+		text += str
+	}
+
+	return text
+}
+
+// unknown IDs (eg. from a later generation) are kept rather than treated as corruption
+func lookup(get func(uint8) (string, error), id uint8) string {
+	name, err := get(id)
+	if err != nil {
+		return fmt.Sprintf("Unknown (#%d)", id)
+	}
+
+	return name
+}
@@ -0,0 +1,99 @@
+package rom_reader
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+type DexEntry struct {
+	Seen        bool
+	Caught      bool
+	SeenGenders []string // in the order they were seen
+	SeenForms   []uint   // in the order they were seen. Only set for species with recorded forms
+}
+
+// Entries are indexed by national dex ID - 1
+type Pokedex struct {
+	NationalDex *bool // nil in DP savefiles, where the flag isn't located yet
+	Entries     [sav.DEX_SPECIES]DexEntry
+}
+
+func (p Pokedex) SeenCount() uint {
+	count := uint(0)
+	for _, e := range p.Entries {
+		if e.Seen {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (p Pokedex) CaughtCount() uint {
+	count := uint(0)
+	for _, e := range p.Entries {
+		if e.Caught {
+			count++
+		}
+	}
+
+	return count
+}
+
+func GetPokedex(game sav.ISave) (Pokedex, error) {
+	gen4, ok := game.(sav.IGen4Save)
+	if !ok || gen4.PokedexLayout() == nil {
+		return Pokedex{}, fmt.Errorf("pokedex data is only supported in gen 4 savefiles")
+	}
+
+	section := gen4.PokedexSection()
+	layout := gen4.PokedexLayout()
+	pokedex := Pokedex{}
+
+	if layout.NationalDexFlag != 0 {
+		unlocked := section[layout.NationalDexFlag] == 1
+		pokedex.NationalDex = &unlocked
+	}
+
+	for i := range pokedex.Entries {
+		species := uint16(i + 1)
+		entry := DexEntry{
+			Seen:   sav.GetDexFlag(section, sav.DEX_SEEN, species),
+			Caught: sav.GetDexFlag(section, sav.DEX_CAUGHT, species),
+		}
+
+		if entry.Seen {
+			entry.SeenGenders = seenGenders(section, species)
+
+			if forms, ok := layout.Forms[species]; ok {
+				entry.SeenForms = forms.Read(section)
+			}
+		}
+
+		pokedex.Entries[i] = entry
+	}
+
+	return pokedex, nil
+}
+
+// genderless and single-gender species are recorded with the same gender twice
+func seenGenders(section []byte, species uint16) []string {
+	first := sav.GetDexFlag(section, sav.DEX_FIRST_GENDER, species)
+	second := sav.GetDexFlag(section, sav.DEX_SECOND_GENDER, species)
+
+	genders := []string{genderName(first)}
+	if first != second {
+		genders = append(genders, genderName(second))
+	}
+
+	return genders
+}
+
+func genderName(female bool) string {
+	if female {
+		return "Female"
+	}
+
+	return "Male"
+}
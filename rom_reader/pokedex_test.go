@@ -0,0 +1,49 @@
+package rom_reader
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func TestGetPokedex(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	pokedex, err := GetPokedex(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if pokedex.NationalDex == nil || *pokedex.NationalDex {
+		t.Fatal("expected the national dex to be locked")
+	}
+
+	if pokedex.SeenCount() != 207 || pokedex.CaughtCount() != 33 {
+		t.Fatalf("expected 207 seen and 33 caught, got %d and %d\n", pokedex.SeenCount(), pokedex.CaughtCount())
+	}
+
+	// Unown F, then Unown D
+	unown := pokedex.Entries[201-1]
+	if len(unown.SeenForms) != 2 || unown.SeenForms[0] != 5 || unown.SeenForms[1] != 3 {
+		t.Fatalf("expected Unown forms [5 3], got %v\n", unown.SeenForms)
+	}
+
+	// Wormadam is female only
+	wormadam := pokedex.Entries[413-1]
+	if len(wormadam.SeenGenders) != 1 || wormadam.SeenGenders[0] != "Female" {
+		t.Fatalf("expected Wormadam genders [Female], got %v\n", wormadam.SeenGenders)
+	}
+
+	if shaymin := pokedex.Entries[492-1]; shaymin.Seen {
+		t.Fatal("expected Shaymin to be unseen")
+	}
+}
@@ -3,9 +3,7 @@ package rom_reader
 import (
 	"encoding/binary"
 	"fmt"
-	"log"
 
-	"github.com/dingdongg/pkmn-rom-parser/v7/char"
 	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
 	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
 	"github.com/dingdongg/pkmn-rom-parser/v7/data"
@@ -29,26 +27,52 @@ type BattleStat struct {
 
 type Pokemon struct {
 	PokedexId uint16
-	Name      string
+	Name      string // nickname, if the pokemon has one
+	Species   string
 	BattleStat
 	Item    string
 	Nature  string
 	Ability string
 	EVs     Stats
 	IVs     Stats
+
+	Personality uint32
+	Moves       [4]Move
+	OT          OriginalTrainer
+	Experience  uint
+	Friendship  uint
+	Met         MetData
+	Ball        string
+	OriginGame  string
+	Language    string
+	// circle, triangle, square, heart, star, diamond
+	Markings         [6]bool
+	IsEgg            bool
+	IsNicknamed      bool
+	IsShiny          bool
+	FatefulEncounter bool
+	Gender           string
+	Form             uint
+	Pokerus          Pokerus
+	ContestStats     ContestStats
+	Ribbons          []string
 }
 
 func (p Pokemon) String() string {
 	return fmt.Sprintf(`
 	Pokemon {
-		%s (#%d) Level: %d
+		%s (%s #%d) Level: %d
 		%+v
 		held item (id): %s
 		Nature: %s
 		Ability: %s
 		EV: %+v
 		IV: %+v
-	}`, p.Name, p.PokedexId, p.BattleStat.Level, p.BattleStat.Stats, p.Item, p.Nature, p.Ability, p.EVs, p.IVs)
+		Moves: %+v
+		OT: %s (%05d)
+		Gender: %s, Shiny: %t, Ball: %s, Origin: %s
+	}`, p.Name, p.Species, p.PokedexId, p.BattleStat.Level, p.BattleStat.Stats, p.Item, p.Nature, p.Ability, p.EVs, p.IVs,
+		p.Moves, p.OT.Name, p.OT.TID, p.Gender, p.IsShiny, p.Ball, p.OriginGame)
 }
 
 const (
@@ -59,36 +83,72 @@ const (
 )
 
 // TODO: update function to use ISave methods instead
-func GetPartyPokemon(game sav.ISave) []Pokemon {
+func GetPartyPokemon(game sav.ISave) ([]Pokemon, error) {
 	size := game.PartySize()
 	ciphertext := game.PartySection()
 	var party []Pokemon
 
 	for i := uint(0); i < uint(size); i++ {
-		party = append(party, parsePokemon(ciphertext, i))
+		parse := parsePokemon
+		if game.Version().IsGen5() {
+			parse = parsePokemonGen5
+		}
+
+		pokemon, err := parse(ciphertext, i)
+		if err != nil {
+			return []Pokemon{}, fmt.Errorf("party pokemon %d: %s", i, err)
+		}
+
+		party = append(party, pokemon)
 	}
 
-	return party
+	return party, nil
 }
 
-func parsePokemon(ciphertext []byte, partyIndex uint) Pokemon {
+func parsePokemon(ciphertext []byte, partyIndex uint) (Pokemon, error) {
 	offset := partyIndex * consts.PARTY_POKEMON_SIZE
-	plaintext := crypt.DecryptPokemon(ciphertext[offset:])
+	plaintext, err := crypt.Decrypt(ciphertext[offset:], consts.PARTY_POKEMON_SIZE)
+	if err != nil {
+		return Pokemon{}, err
+	}
+
+	return decodePokemon(plaintext, false)
+}
+
+// gen 5 party pokemon are 220 bytes long, but share the gen 4 encryption and block layout
+func parsePokemonGen5(ciphertext []byte, partyIndex uint) (Pokemon, error) {
+	offset := partyIndex * consts.PARTY_POKEMON_SIZE_GEN5
+	plaintext, err := crypt.Decrypt(ciphertext[offset:], consts.PARTY_POKEMON_SIZE_GEN5)
+	if err != nil {
+		return Pokemon{}, err
+	}
+
+	return decodePokemon(plaintext, true)
+}
+
+// gen 5 pokemon store their nature in block B instead of deriving it from the
+// personality value, and encode nicknames as UTF-16
+func decodePokemon(plaintext []byte, gen5 bool) (Pokemon, error) {
 	personality := binary.LittleEndian.Uint32(plaintext[0:4])
 
 	blockA, err := shuffler.GetPokemonBlock(plaintext, A, personality)
 	if err != nil {
-		log.Fatal("Unexpected error while parsing block A: ", err)
+		return Pokemon{}, err
 	}
 
 	blockB, err := shuffler.GetPokemonBlock(plaintext, B, personality)
 	if err != nil {
-		log.Fatal("Unexpected error while parsing block B: ", err)
+		return Pokemon{}, err
 	}
 
 	blockC, err := shuffler.GetPokemonBlock(plaintext, C, personality)
 	if err != nil {
-		log.Fatal("Unexpected error while parsing block C: ", err)
+		return Pokemon{}, err
+	}
+
+	blockD, err := shuffler.GetPokemonBlock(plaintext, D, personality)
+	if err != nil {
+		return Pokemon{}, err
 	}
 
 	ivBytes := binary.LittleEndian.Uint32(blockB[0x10:0x14])
@@ -105,32 +165,39 @@ func parsePokemon(ciphertext []byte, partyIndex uint) Pokemon {
 	// fmt.Printf("% x\n", blockB[0x10:0x14])
 
 	dexId := binary.LittleEndian.Uint16(blockA[:2])
-	heldItem, err := data.GetItem(binary.LittleEndian.Uint16(blockA[2:4]))
+	species, err := data.GetSpecies(dexId)
 	if err != nil {
-		log.Fatal("error while parsing item: ", err)
+		// species introduced in gen 5 are missing from the gen 4 species table
+		species.Name = fmt.Sprintf("Unknown (#%d)", dexId)
 	}
 
-	nature, err := data.GetNature(uint(personality % 25))
+	itemId := binary.LittleEndian.Uint16(blockA[2:4])
+	heldItem, err := data.GetItem(itemId)
 	if err != nil {
-		log.Fatal("error while parsing nature: ", err)
+		// items introduced in gen 5 are missing from the gen 4 item table
+		if !gen5 {
+			return Pokemon{}, fmt.Errorf("invalid held item %d", itemId)
+		}
+		heldItem.Name = fmt.Sprintf("Unknown (#%d)", itemId)
+	}
+
+	natureIndex := uint(personality % 25)
+	if gen5 {
+		natureIndex = uint(blockB[consts.BLOCK_B_NATURE_GEN5])
+	}
+
+	nature, err := data.GetNature(natureIndex)
+	if err != nil {
+		return Pokemon{}, fmt.Errorf("invalid nature %d", natureIndex)
 	}
 
 	ability, err := data.GetAbility(uint(blockA[0xD]))
 	if err != nil {
-		log.Fatal("error while parsing ability: ", err)
+		return Pokemon{}, fmt.Errorf("invalid ability %d", blockA[0xD])
 	}
 
 	pokemonNameLength := 22
-	name := ""
-
-	for i := 0; i < pokemonNameLength; i += 2 {
-		charIndex := binary.LittleEndian.Uint16(blockC[i : i+2])
-		str, err := char.Char(charIndex)
-		if err != nil {
-			break
-		}
-		name += str
-	}
+	name := decodeText(blockC[consts.BLOCK_C_NICKNAME:consts.BLOCK_C_NICKNAME+pokemonNameLength], gen5)
 
 	battleStatsPlaintext := plaintext[0x88:]
 	battleStats := BattleStat{
@@ -164,14 +231,15 @@ func parsePokemon(ciphertext []byte, partyIndex uint) Pokemon {
 		evSum += int(blockA[hpEVOffset+i])
 	}
 
-	return Pokemon{
-		dexId,
-		name,
-		battleStats,
-		heldItem.Name,
-		nature,
-		ability,
-		Stats{
+	pokemon := Pokemon{
+		PokedexId:  dexId,
+		Name:       name,
+		Species:    species.Name,
+		BattleStat: battleStats,
+		Item:       heldItem.Name,
+		Nature:     nature,
+		Ability:    ability,
+		EVs: Stats{
 			uint(blockA[hpEVOffset]),
 			uint(blockA[attackEVOffset]),
 			uint(blockA[defenseEVOffset]),
@@ -179,6 +247,10 @@ func parsePokemon(ciphertext []byte, partyIndex uint) Pokemon {
 			uint(blockA[specialDefEVOffset]),
 			uint(blockA[speedEVOffset]),
 		},
-		ivs,
+		IVs:         ivs,
+		Personality: personality,
 	}
+
+	decodeDetails(&pokemon, blockA, blockB, blockC, blockD, gen5)
+	return pokemon, nil
 }
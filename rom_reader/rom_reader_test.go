@@ -1,11 +1,14 @@
 package rom_reader
 
 import (
+	"encoding/binary"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -43,21 +46,24 @@ func TestParsePokemon(t *testing.T) {
 		t.Fatal("Unexpected error ", err)
 	}
 
-	firstPokemon := parsePokemon(savefile[:], 0)
+	firstPokemon, err := parsePokemon(savefile[:], 0)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
 
-	expectedPokemon := Pokemon{
-		461,
-		"WEAVILE",
-		BattleStat{
+	expectedPokemon := withMockDetails(Pokemon{
+		PokedexId: 461,
+		Name:      "WEAVILE",
+		BattleStat: BattleStat{
 			58,
 			Stats{163, 181, 93, 63, 106, 215},
 		},
-		"None",
-		"Jolly",
-		"Pressure",
-		Stats{0, 255, 0, 0, 3, 252},
-		Stats{25, 1, 23, 25, 5, 17},
-	}
+		Item:    "None",
+		Nature:  "Jolly",
+		Ability: "Pressure",
+		EVs:     Stats{0, 255, 0, 0, 3, 252},
+		IVs:     Stats{25, 1, 23, 25, 5, 17},
+	})
 
 	if !cmp.Equal(firstPokemon, expectedPokemon) {
 		t.Fatalf("expected %+v, but got %+v\n", expectedPokemon, firstPokemon)
@@ -70,24 +76,152 @@ func TestParseUpdatedPokemon(t *testing.T) {
 		t.Fatal("Unexpected error ", err)
 	}
 
-	firstPokemon := parsePokemon(savefile[consts.PERSONALITY_OFFSET:], 0)
+	firstPokemon, err := parsePokemon(savefile[consts.PERSONALITY_OFFSET:], 0)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
 
 	fmt.Printf("result: %+v\n", firstPokemon)
-	expectedPokemon := Pokemon{
-		461,
-		"ABCDE",
-		BattleStat{
+	expectedPokemon := withMockDetails(Pokemon{
+		PokedexId: 461,
+		Name:      "ABCDE",
+		BattleStat: BattleStat{
 			100,
 			Stats{123, 456, 789, 999, 111, 101},
 		},
-		"Safari Ball",
-		"Jolly",
-		"Pressure",
-		Stats{255, 255, 255, 255, 255, 255},
-		Stats{31, 31, 31, 31, 31, 31},
+		Item:    "Safari Ball",
+		Nature:  "Jolly",
+		Ability: "Pressure",
+		EVs:     Stats{255, 255, 255, 255, 255, 255},
+		IVs:     Stats{31, 31, 31, 31, 31, 31},
+	})
+
+	if !cmp.Equal(firstPokemon, expectedPokemon) {
+		t.Fatalf("expected %+v, but got %+v\n", expectedPokemon, firstPokemon)
+	}
+}
+
+func TestParseGen5Pokemon(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/mock_pokemon_data")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
 	}
 
+	// turn the gen 4 mock into a gen 5 one: UTF-16 nickname, and a nature
+	// byte that disagrees with the personality value
+	plaintext, err := crypt.DecryptPokemon(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	blockB, _ := shuffler.GetPokemonBlockLocation(B, personality)
+	plaintext[blockB+consts.BLOCK_B_NATURE_GEN5] = 3 // Adamant
+
+	blockC, _ := shuffler.GetPokemonBlockLocation(C, personality)
+	name := []uint16{'W', 'e', 'a', 'v', 'i', 'l', 'e', 0x246E, 0xFFFF}
+	for i, c := range name {
+		binary.LittleEndian.PutUint16(plaintext[blockC+uint(i*2):], c)
+	}
+
+	blockD, _ := shuffler.GetPokemonBlockLocation(D, personality)
+	ot := []uint16{'D', 'O', 'N', 'G', 'G', 'Y', 'U', 0xFFFF}
+	for i, c := range ot {
+		binary.LittleEndian.PutUint16(plaintext[blockD+uint(i*2):], c)
+	}
+
+	ciphertext := crypt.EncryptPokemon(plaintext)[:consts.PARTY_POKEMON_SIZE_GEN5]
+	// the party section runs until the end of the savefile, so pad the mock the same way
+	ciphertext = append(ciphertext, make([]byte, 0x10)...)
+
+	firstPokemon, err := parsePokemonGen5(ciphertext, 0)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	expectedPokemon := withMockDetails(Pokemon{
+		PokedexId: 461,
+		Name:      "Weavile♀",
+		BattleStat: BattleStat{
+			58,
+			Stats{163, 181, 93, 63, 106, 215},
+		},
+		Item:    "None",
+		Nature:  "Adamant",
+		Ability: "Pressure",
+		EVs:     Stats{0, 255, 0, 0, 3, 252},
+		IVs:     Stats{25, 1, 23, 25, 5, 17},
+	})
+
 	if !cmp.Equal(firstPokemon, expectedPokemon) {
 		t.Fatalf("expected %+v, but got %+v\n", expectedPokemon, firstPokemon)
 	}
 }
+
+// every test pokemon derives from the same WEAVILE, caught in Platinum
+func withMockDetails(p Pokemon) Pokemon {
+	p.Species = "Weavile"
+	p.Personality = 0x94DFB7DB
+	p.Moves = [4]Move{{400, "Night Slash", 15, 0}, {420, "Ice Shard", 30, 0}, {280, "Brick Break", 15, 0}, {8, "Ice Punch", 15, 0}}
+	p.OT = OriginalTrainer{"DONGGYU", 26241, 11961, "Male"}
+	p.Experience = 191385
+	p.Friendship = 255
+	p.Met = MetData{Location: 74, Level: 35, Date: Date{2024, 4, 29}}
+	p.Ball = "Poké Ball"
+	p.OriginGame = "Platinum"
+	p.Language = "English"
+	p.Gender = "Male"
+	p.Ribbons = []string{"Sinnoh Champ", "Effort"}
+	return p
+}
+
+func TestDecodeDetails(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/mock_pokemon_data")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	plaintext, err := crypt.DecryptPokemon(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	blockA, _ := shuffler.GetPokemonBlockLocation(A, personality)
+	plaintext[blockA+consts.BLOCK_A_MARKINGS] = 0b100101
+	plaintext[blockA+consts.BLOCK_A_CONTEST+5] = 40
+
+	blockB, _ := shuffler.GetPokemonBlockLocation(B, personality)
+	plaintext[blockB+consts.BLOCK_B_FLAGS] = 0b10011 // fateful, female, form 2
+	plaintext[blockB+consts.BLOCK_B_IV+3] |= 0xC0    // egg, nicknamed
+
+	blockD, _ := shuffler.GetPokemonBlockLocation(D, personality)
+	plaintext[blockD+consts.BLOCK_D_POKERUS] = 0x32
+	plaintext[blockD+consts.BLOCK_D_BALL_HGSS] = 18 // Level Ball
+	plaintext[blockD+consts.BLOCK_D_MET_LEVEL] |= 0x80
+
+	pokemon, err := decodePokemon(plaintext, false)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if pokemon.Markings != [6]bool{true, false, true, false, false, true} {
+		t.Fatalf("expected circle, square and diamond markings, but got %+v\n", pokemon.Markings)
+	}
+
+	if pokemon.ContestStats.Sheen != 40 || pokemon.Pokerus != (Pokerus{3, 2}) {
+		t.Fatalf("expected sheen 40 and pokerus {3 2}, but got %d and %+v\n", pokemon.ContestStats.Sheen, pokemon.Pokerus)
+	}
+
+	if !pokemon.FatefulEncounter || pokemon.Gender != "Female" || pokemon.Form != 2 {
+		t.Fatalf("expected a female fateful encounter in form 2, but got %t %s %d\n", pokemon.FatefulEncounter, pokemon.Gender, pokemon.Form)
+	}
+
+	if !pokemon.IsEgg || !pokemon.IsNicknamed {
+		t.Fatalf("expected egg and nicknamed flags to be set, but got %t %t\n", pokemon.IsEgg, pokemon.IsNicknamed)
+	}
+
+	if pokemon.Ball != "Level Ball" || pokemon.OT.Gender != "Female" {
+		t.Fatalf("expected a Level Ball and a female OT, but got %s and %s\n", pokemon.Ball, pokemon.OT.Gender)
+	}
+}
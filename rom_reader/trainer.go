@@ -0,0 +1,100 @@
+package rom_reader
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/char"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+type PlayTime struct {
+	Hours   uint
+	Minutes uint
+	Seconds uint
+}
+
+// Badges are indexed in the order the gym leaders are meant to be challenged.
+// KantoBadges are only set in HGSS
+type Trainer struct {
+	Name        string
+	TID         uint16
+	SID         uint16
+	Gender      string
+	Money       uint
+	Badges      [8]bool
+	KantoBadges [8]bool
+	PlayTime    PlayTime
+}
+
+func (t Trainer) String() string {
+	return fmt.Sprintf(`
+	Trainer {
+		%s (%s) TID: %d SID: %d
+		Money: %d
+		Badges: %v
+		Kanto badges: %v
+		Play time: %d:%02d:%02d
+	}`, t.Name, t.Gender, t.TID, t.SID, t.Money, t.Badges, t.KantoBadges, t.PlayTime.Hours, t.PlayTime.Minutes, t.PlayTime.Seconds)
+}
+
+func GetTrainer(game sav.ISave) (Trainer, error) {
+	gen4, ok := game.(sav.IGen4Save)
+	if !ok {
+		return Trainer{}, fmt.Errorf("trainer data is only supported in gen 4 savefiles")
+	}
+
+	section := gen4.TrainerSection()
+
+	gender := "Male"
+	if section[consts.TRAINER_GENDER] == 1 {
+		gender = "Female"
+	}
+
+	trainer := Trainer{
+		Name:   decodeName(section[consts.TRAINER_NAME : consts.TRAINER_NAME+consts.TRAINER_NAME_SIZE]),
+		TID:    binary.LittleEndian.Uint16(section[consts.TRAINER_TID:]),
+		SID:    binary.LittleEndian.Uint16(section[consts.TRAINER_SID:]),
+		Gender: gender,
+		Money:  uint(binary.LittleEndian.Uint32(section[consts.TRAINER_MONEY:])),
+		Badges: decodeFlags(section[consts.TRAINER_BADGES]),
+		PlayTime: PlayTime{
+			uint(binary.LittleEndian.Uint16(section[consts.TRAINER_PLAY_HOURS:])),
+			uint(section[consts.TRAINER_PLAY_MINUTES]),
+			uint(section[consts.TRAINER_PLAY_SECONDS]),
+		},
+	}
+
+	if game.Version() == gamever.HGSS {
+		trainer.KantoBadges = decodeFlags(section[consts.TRAINER_BADGES_KANTO])
+	}
+
+	return trainer, nil
+}
+
+// one bit per flag, starting with the least significant bit
+func decodeFlags(flags byte) [8]bool {
+	var badges [8]bool
+	for i := range badges {
+		badges[i] = flags&(1<<i) != 0
+	}
+
+	return badges
+}
+
+// decodes a gen 4 string, stopping at the terminator
+func decodeName(buf []byte) string {
+	name := ""
+
+	for i := 0; i+1 < len(buf); i += 2 {
+		str, err := char.Char(binary.LittleEndian.Uint16(buf[i : i+2]))
+		if err != nil {
+			break
+		}
+		name += str
+	}
+
+	return name
+}
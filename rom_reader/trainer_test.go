@@ -0,0 +1,49 @@
+package rom_reader
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetTrainer(t *testing.T) {
+	savefile, err := os.ReadFile("./mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	trainer, err := GetTrainer(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	expected := Trainer{
+		Name:     "DONGGYU",
+		TID:      26241,
+		SID:      11961,
+		Gender:   "Male",
+		Money:    93200,
+		Badges:   [8]bool{true, true, true, true, true, true, true, true},
+		PlayTime: PlayTime{64, 23, 3},
+	}
+
+	if !cmp.Equal(trainer, expected) {
+		t.Fatalf("expected %+v, but got %+v\n", expected, trainer)
+	}
+}
+
+func TestDecodeFlags(t *testing.T) {
+	expected := [8]bool{true, false, true, false, false, false, false, true}
+
+	badges := decodeFlags(0b1000_0101)
+	if badges != expected {
+		t.Fatalf("expected %v, but got %v\n", expected, badges)
+	}
+}
@@ -0,0 +1,151 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+type bagSlot struct {
+	id       uint16
+	quantity uint16
+}
+
+func UpdateBag(savefile sav.ISave, newData *req.BagWriteRequest) ([]byte, error) {
+	game, ok := savefile.(sav.IGen4Save)
+	if !ok {
+		return []byte{}, fmt.Errorf("bag data is only supported in gen 4 savefiles")
+	}
+
+	section := game.BagSection()
+	pockets := make(map[pocket.Pocket]sav.BagPocket)
+	contents := make(map[pocket.Pocket][]bagSlot)
+
+	for _, p := range game.BagPockets() {
+		pockets[p.Pocket] = p
+		contents[p.Pocket] = readPocket(section, p)
+	}
+
+	itemMap := data.GenerateItemMap()
+
+	// every change is staged first, so that a bad request doesn't leave the savefile half-written
+	for _, write := range newData.Items {
+		item, ok := itemMap[write.Item]
+		if !ok {
+			return []byte{}, fmt.Errorf("item '%s' doesn't exist", write.Item)
+		}
+
+		if err := validateBagItem(savefile.Version(), write, uint16(item.Index), item.Exclusivity); err != nil {
+			return []byte{}, err
+		}
+
+		slots, err := setQuantity(contents[write.Pocket], uint16(item.Index), uint16(write.Quantity))
+		if err != nil {
+			return []byte{}, err
+		}
+
+		if uint(len(slots)) > pockets[write.Pocket].Slots {
+			return []byte{}, fmt.Errorf("%s pocket is full: %d items", write.Pocket, pockets[write.Pocket].Slots)
+		}
+
+		contents[write.Pocket] = slots
+	}
+
+	for p, slots := range contents {
+		writePocket(section, pockets[p], slots)
+	}
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+func validateBagItem(version gamever.GameVer, write req.BagItemWrite, id uint16, exclusivity string) error {
+	p, err := data.GetItemPocket(id)
+	if err != nil {
+		return err
+	}
+
+	if p != write.Pocket {
+		return fmt.Errorf("%s belongs in the %s pocket, not the %s pocket", write.Item, p, write.Pocket)
+	}
+
+	if exclusivity == "HGSS" && version != gamever.HGSS {
+		return fmt.Errorf("%s only exists in HGSS", write.Item)
+	}
+
+	if exclusivity == "PT" && version == gamever.HGSS {
+		return fmt.Errorf("%s doesn't exist in HGSS", write.Item)
+	}
+
+	if limit := quantityCap(p); write.Quantity > limit {
+		return fmt.Errorf("can't hold more than %d %s", limit, write.Item)
+	}
+
+	return nil
+}
+
+func quantityCap(p pocket.Pocket) uint {
+	switch p {
+	case pocket.KEY_ITEMS:
+		return consts.MAX_KEY_ITEM_QUANTITY
+	case pocket.TMS_HMS:
+		return consts.MAX_TM_QUANTITY
+	}
+
+	return consts.MAX_ITEM_QUANTITY
+}
+
+// updates the item's slot, appends it if it's new, or removes it if the quantity is 0
+func setQuantity(slots []bagSlot, id uint16, quantity uint16) ([]bagSlot, error) {
+	for i, s := range slots {
+		if s.id != id {
+			continue
+		}
+
+		if quantity == 0 {
+			return append(slots[:i:i], slots[i+1:]...), nil
+		}
+
+		slots[i].quantity = quantity
+		return slots, nil
+	}
+
+	if quantity == 0 {
+		return slots, nil
+	}
+
+	return append(slots, bagSlot{id, quantity}), nil
+}
+
+// empty slots are skipped, like the games do when they compact the pocket
+func readPocket(section []byte, p sav.BagPocket) []bagSlot {
+	slots := make([]bagSlot, 0)
+
+	for slot := uint(0); slot < p.Slots; slot++ {
+		offset := p.Offset + slot*sav.BAG_SLOT_SIZE
+		id := binary.LittleEndian.Uint16(section[offset : offset+2])
+		if id == 0 {
+			continue
+		}
+
+		slots = append(slots, bagSlot{id, binary.LittleEndian.Uint16(section[offset+2 : offset+4])})
+	}
+
+	return slots
+}
+
+func writePocket(section []byte, p sav.BagPocket, slots []bagSlot) {
+	pocketData := section[p.Offset : p.Offset+p.Slots*sav.BAG_SLOT_SIZE]
+	clear(pocketData)
+
+	for i, s := range slots {
+		binary.LittleEndian.PutUint16(pocketData[i*sav.BAG_SLOT_SIZE:], s.id)
+		binary.LittleEndian.PutUint16(pocketData[i*sav.BAG_SLOT_SIZE+2:], s.quantity)
+	}
+}
@@ -0,0 +1,78 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func findPocket(game sav.IGen4Save, p pocket.Pocket) sav.BagPocket {
+	for _, bp := range game.BagPockets() {
+		if bp.Pocket == p {
+			return bp
+		}
+	}
+
+	return sav.BagPocket{}
+}
+
+func TestUpdateBag(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	bwr := req.NewBagWriteRequest()
+	bwr.SetItem(pocket.ITEMS, "Max Repel", 99)  // first slot, re-counted
+	bwr.RemoveItem(pocket.ITEMS, "White Flute") // second slot
+	bwr.SetItem(pocket.MAIL, "Grass Mail", 3)   // empty pocket
+
+	updated, err := UpdateBag(game, bwr)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game = revalidate(t, updated)
+	section := game.BagSection()
+
+	items := readPocket(section, findPocket(game, pocket.ITEMS))
+	if items[0] != (bagSlot{77, 99}) {
+		t.Fatalf("expected Max Repel x99, got %+v\n", items[0])
+	}
+
+	// the pocket is compacted once the White Flute is removed
+	if items[1].id != 72 {
+		t.Fatalf("expected Red Shard to move up, got %+v\n", items[1])
+	}
+
+	mail := findPocket(game, pocket.MAIL)
+	if binary.LittleEndian.Uint16(section[mail.Offset:]) != 137 {
+		t.Fatalf("expected Grass Mail in the first mail slot, got % x\n", section[mail.Offset:mail.Offset+4])
+	}
+}
+
+func TestUpdateBagValidation(t *testing.T) {
+	cases := []struct {
+		pocket   pocket.Pocket
+		item     string
+		quantity uint
+	}{
+		{pocket.MEDICINE, "Master Ball", 1},   // wrong pocket
+		{pocket.ITEMS, "Max Repel", 1000},     // above the cap
+		{pocket.TMS_HMS, "TM01", 100},         // above the TM cap
+		{pocket.KEY_ITEMS, "Bicycle", 2},      // key items don't stack
+		{pocket.POKE_BALLS, "Fast Ball", 1},   // HGSS exclusive
+		{pocket.KEY_ITEMS, "Red Apricorn", 1}, // not stored in the bag
+		{pocket.ITEMS, "Missingno", 1},        // doesn't exist
+	}
+
+	for _, c := range cases {
+		game := readPlatinumMock(t)
+		bwr := req.NewBagWriteRequest()
+		bwr.SetItem(c.pocket, c.item, c.quantity)
+
+		if _, err := UpdateBag(game, bwr); err == nil {
+			t.Fatalf("expected %d %s in the %s pocket to be rejected\n", c.quantity, c.item, c.pocket)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package rom_writer
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func UpdateBoxPokemon(savefile sav.ISave, newData []req.BoxWriteRequest) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	// decrypted pokemon, keyed by their offset in the box section
+	changes := make(StagingMap)
+
+	for _, bwr := range newData {
+		offset, err := boxSlotOffset(game, bwr.Box, bwr.Slot)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		boxes := game.BoxSection()
+		if sav.IsEmptySlot(boxes[offset:]) {
+			return []byte{}, fmt.Errorf("box %d slot %d is empty", bwr.Box, bwr.Slot)
+		}
+
+		for request, data := range bwr.Contents {
+			if _, blockIndex, err := req.GetWriteLocation(request); err == nil && blockIndex == -1 {
+				return []byte{}, fmt.Errorf("box pokemon don't store battle stats, can't write '%s'", request)
+			}
+
+			if _, ok := changes[offset]; !ok {
+				plaintext, err := crypt.Decrypt(boxes[offset:], consts.BOX_POKEMON_SIZE)
+				if err != nil {
+					return []byte{}, fmt.Errorf("box %d slot %d: %s", bwr.Box, bwr.Slot, err)
+				}
+				changes[offset] = plaintext
+			}
+
+			if err := writeField(savefile, changes[offset], request, data); err != nil {
+				return []byte{}, err
+			}
+		}
+	}
+
+	boxes := game.BoxSection()
+	for offset, plaintext := range changes {
+		encrypted, err := crypt.Encrypt(plaintext, consts.BOX_POKEMON_SIZE)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		copy(boxes[offset:offset+consts.BOX_POKEMON_SIZE], encrypted)
+	}
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+// Stores the given decrypted pokemon into an empty slot. Only the first 136 bytes are used
+func InsertBoxPokemon(savefile sav.ISave, box, slot uint, plaintext []byte) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	offset, err := boxSlotOffset(game, box, slot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if len(plaintext) < consts.BOX_POKEMON_SIZE {
+		return []byte{}, fmt.Errorf("expected at least %d bytes of pokemon data, got %d", consts.BOX_POKEMON_SIZE, len(plaintext))
+	}
+
+	boxes := game.BoxSection()
+	if !sav.IsEmptySlot(boxes[offset:]) {
+		return []byte{}, fmt.Errorf("box %d slot %d is already occupied", box, slot)
+	}
+
+	encrypted, err := crypt.Encrypt(plaintext, consts.BOX_POKEMON_SIZE)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	copy(boxes[offset:offset+consts.BOX_POKEMON_SIZE], encrypted)
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+func ClearBoxSlot(savefile sav.ISave, box, slot uint) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	offset, err := boxSlotOffset(game, box, slot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	clear(game.BoxSection()[offset : offset+consts.BOX_POKEMON_SIZE])
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+// Swaps the contents of two box slots, either of which can be empty
+func MoveBoxPokemon(savefile sav.ISave, fromBox, fromSlot, toBox, toSlot uint) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	from, err := boxSlotOffset(game, fromBox, fromSlot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	to, err := boxSlotOffset(game, toBox, toSlot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	// pokemon data stays encrypted with its own personality value, so it can be moved as is
+	boxes := game.BoxSection()
+	tmp := make([]byte, consts.BOX_POKEMON_SIZE)
+	copy(tmp, boxes[from:from+consts.BOX_POKEMON_SIZE])
+	copy(boxes[from:from+consts.BOX_POKEMON_SIZE], boxes[to:to+consts.BOX_POKEMON_SIZE])
+	copy(boxes[to:to+consts.BOX_POKEMON_SIZE], tmp)
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+// Moves a party pokemon into an empty box slot. The rest of the party is shifted up,
+// like the games do. The last pokemon in the party can't be deposited
+func DepositPokemon(savefile sav.ISave, partyIndex, box, slot uint) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	offset, err := boxSlotOffset(game, box, slot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	partySize := uint(game.PartySize())
+	if partyIndex >= partySize {
+		return []byte{}, fmt.Errorf("invalid party index %d", partyIndex)
+	}
+
+	if partySize == 1 {
+		return []byte{}, fmt.Errorf("can't deposit the last pokemon in the party")
+	}
+
+	boxes := game.BoxSection()
+	if !sav.IsEmptySlot(boxes[offset:]) {
+		return []byte{}, fmt.Errorf("box %d slot %d is already occupied", box, slot)
+	}
+
+	// box pokemon use the same encryption as the first 136 bytes of a party pokemon
+	party := game.PartySection()
+	partyOffset := partyIndex * consts.PARTY_POKEMON_SIZE
+	copy(boxes[offset:offset+consts.BOX_POKEMON_SIZE], party[partyOffset:])
+
+	partyEnd := partySize * consts.PARTY_POKEMON_SIZE
+	copy(party[partyOffset:partyEnd], party[partyOffset+consts.PARTY_POKEMON_SIZE:partyEnd])
+	clear(party[partyEnd-consts.PARTY_POKEMON_SIZE : partyEnd])
+	game.SetPartySize(uint32(partySize - 1))
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+// Moves a box pokemon to the end of the party. Box pokemon have no battle stats, and the
+// game doesn't recompute them on load, so the level is derived from EXP and the stats are
+// computed, at full HP
+func WithdrawPokemon(savefile sav.ISave, box, slot uint) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	offset, err := boxSlotOffset(game, box, slot)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	partySize := uint(game.PartySize())
+	if partySize >= 6 {
+		return []byte{}, fmt.Errorf("party is full: 6 pokemon")
+	}
+
+	boxes := game.BoxSection()
+	if sav.IsEmptySlot(boxes[offset:]) {
+		return []byte{}, fmt.Errorf("box %d slot %d is empty", box, slot)
+	}
+
+	boxPlaintext, err := crypt.Decrypt(boxes[offset:], consts.BOX_POKEMON_SIZE)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	plaintext := make([]byte, consts.PARTY_POKEMON_SIZE)
+	copy(plaintext, boxPlaintext)
+
+	if err := initBattleStats(savefile, plaintext); err != nil {
+		return []byte{}, err
+	}
+
+	partyOffset := partySize * consts.PARTY_POKEMON_SIZE
+	copy(game.PartySection()[partyOffset:], crypt.EncryptPokemon(plaintext))
+
+	clear(boxes[offset : offset+consts.BOX_POKEMON_SIZE])
+	game.SetPartySize(uint32(partySize + 1))
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+func asGen4(savefile sav.ISave) (sav.IGen4Save, error) {
+	game, ok := savefile.(sav.IGen4Save)
+	if !ok {
+		return nil, fmt.Errorf("box data is only supported in gen 4 savefiles")
+	}
+
+	return game, nil
+}
+
+// offset of the slot, relative to the start of the box section
+func boxSlotOffset(game sav.IGen4Save, box, slot uint) (uint, error) {
+	if box >= consts.BOX_COUNT {
+		return 0, fmt.Errorf("invalid box %d", box)
+	}
+
+	if slot >= consts.BOX_SLOTS {
+		return 0, fmt.Errorf("invalid box slot %d", slot)
+	}
+
+	return box*game.BoxSize() + slot*consts.BOX_POKEMON_SIZE, nil
+}
@@ -0,0 +1,146 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/dingdongg/pkmn-rom-parser/v7/stats"
+)
+
+func readPlatinumMock(t *testing.T) sav.IGen4Save {
+	savefile, err := os.ReadFile("../rom_reader/mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := sav.Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	return game.(sav.IGen4Save)
+}
+
+func revalidate(t *testing.T, updated []byte) sav.IGen4Save {
+	game, err := sav.Validate(updated)
+	if err != nil {
+		t.Fatal("expected updated savefile to pass validation, got ", err)
+	}
+
+	return game.(sav.IGen4Save)
+}
+
+func TestDepositAndWithdraw(t *testing.T) {
+	game := readPlatinumMock(t)
+	partySize := game.PartySize()
+
+	// slot 29 of the last box is empty in the mock savefile
+	updated, err := DepositPokemon(game, 0, 17, 29)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game = revalidate(t, updated)
+	if game.PartySize() != partySize-1 {
+		t.Fatalf("expected party size %d, got %d\n", partySize-1, game.PartySize())
+	}
+
+	offset, _ := boxSlotOffset(game, 17, 29)
+	if sav.IsEmptySlot(game.BoxSection()[offset:]) {
+		t.Fatal("expected deposited pokemon to occupy the box slot")
+	}
+
+	updated, err = WithdrawPokemon(game, 17, 29)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game = revalidate(t, updated)
+	if game.PartySize() != partySize {
+		t.Fatalf("expected party size %d, got %d\n", partySize, game.PartySize())
+	}
+
+	if !sav.IsEmptySlot(game.BoxSection()[offset:]) {
+		t.Fatal("expected withdrawn pokemon's box slot to be empty")
+	}
+
+	// the level and battle stats are rebuilt from the box data
+	withdrawn := readParty(t, game)[partySize-1]
+	species, err := data.GetSpecies(withdrawn.PokedexId)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	level, err := data.LevelForExperience(species.GrowthRate, withdrawn.Experience)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if withdrawn.Level == 0 || withdrawn.Level != level {
+		t.Fatalf("expected level %d, got %d\n", level, withdrawn.Level)
+	}
+
+	expected, err := stats.Calculate(withdrawn.PokedexId, withdrawn.IVs, withdrawn.EVs, withdrawn.Level, withdrawn.Nature)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if withdrawn.Stats != expected {
+		t.Fatalf("expected %+v, but got %+v\n", expected, withdrawn.Stats)
+	}
+
+	plaintext, err := crypt.DecryptPokemon(game.PartySection()[(partySize-1)*consts.PARTY_POKEMON_SIZE:])
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	currentHp := binary.LittleEndian.Uint16(plaintext[consts.BATTLE_STATS_OFFSET+consts.BATTLE_STATS_CURRENT_HP:])
+	if uint(currentHp) != expected.Hp {
+		t.Fatalf("expected %d HP, but got %d\n", expected.Hp, currentHp)
+	}
+}
+
+func TestBoxSlotValidation(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	if _, err := InsertBoxPokemon(game, 0, 0, make([]byte, 136)); err == nil {
+		t.Fatal("expected insertion into an occupied slot to be rejected")
+	}
+
+	if _, err := ClearBoxSlot(game, 18, 0); err == nil {
+		t.Fatal("expected box 18 to be rejected")
+	}
+
+	bwr := req.NewBoxWriteRequest(0, 0)
+	bwr.WriteLevel(50)
+	if _, err := UpdateBoxPokemon(game, []req.BoxWriteRequest{bwr}); err == nil {
+		t.Fatal("expected level write to a box pokemon to be rejected")
+	}
+}
+
+func TestMoveBoxPokemon(t *testing.T) {
+	game := readPlatinumMock(t)
+	from, _ := boxSlotOffset(game, 0, 0)
+	to, _ := boxSlotOffset(game, 17, 29)
+	personality := string(game.BoxSection()[from : from+4])
+
+	updated, err := MoveBoxPokemon(game, 0, 0, 17, 29)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game = revalidate(t, updated)
+	if !sav.IsEmptySlot(game.BoxSection()[from:]) {
+		t.Fatal("expected source slot to be empty")
+	}
+
+	if string(game.BoxSection()[to:to+4]) != personality {
+		t.Fatal("expected pokemon to be moved to the destination slot")
+	}
+}
@@ -0,0 +1,72 @@
+package rom_writer
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+// Special wallpapers can only be set once unlocked, either beforehand or by the same request
+func UpdateBoxes(savefile sav.ISave, newData req.BoxesWriteRequest) ([]byte, error) {
+	game, err := asGen4(savefile)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	section := game.BoxMetadataSection()
+
+	unlocked := section[consts.BOX_WALLPAPER_FLAGS]
+	for special, flag := range newData.UnlockedWallpapers {
+		if special >= consts.SPECIAL_WALLPAPERS {
+			return []byte{}, fmt.Errorf("invalid special wallpaper %d", special)
+		}
+
+		unlocked &^= 1 << special
+		if flag {
+			unlocked |= 1 << special
+		}
+	}
+
+	// validate everything first, so that a bad request doesn't leave the savefile half-written
+	changes := make(map[int][]byte)
+
+	for box, data := range newData.Names {
+		if box >= consts.BOX_COUNT {
+			return []byte{}, fmt.Errorf("invalid box %d", box)
+		}
+
+		bytes, err := data.Bytes()
+		if err != nil {
+			return []byte{}, err
+		}
+
+		changes[int(box)*consts.BOX_NAME_SIZE] = bytes
+	}
+
+	for box, data := range newData.Wallpapers {
+		if box >= consts.BOX_COUNT {
+			return []byte{}, fmt.Errorf("invalid box %d", box)
+		}
+
+		bytes, err := data.Bytes()
+		if err != nil {
+			return []byte{}, err
+		}
+
+		if special := int(bytes[0]) - consts.REGULAR_WALLPAPERS; special >= 0 && unlocked&(1<<special) == 0 {
+			return []byte{}, fmt.Errorf("wallpaper %d hasn't been unlocked", bytes[0])
+		}
+
+		changes[consts.BOX_WALLPAPERS+int(box)] = bytes
+	}
+
+	for dataOffset, bytes := range changes {
+		copy(section[dataOffset:], bytes)
+	}
+	section[consts.BOX_WALLPAPER_FLAGS] = unlocked
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
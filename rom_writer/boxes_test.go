@@ -0,0 +1,41 @@
+package rom_writer
+
+import (
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+)
+
+func TestUpdateBoxes(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	bwr := req.NewBoxesWriteRequest()
+	bwr.WriteBoxName(0, "ABC")
+	bwr.WriteWallpaper(1, 20)
+
+	if _, err := UpdateBoxes(game, bwr); err == nil {
+		t.Fatal("expected locked special wallpaper to be rejected")
+	}
+
+	bwr.UnlockWallpaper(4, true)
+	updated, err := UpdateBoxes(game, bwr)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	section := revalidate(t, updated).BoxMetadataSection()
+
+	// 'A' is 0x12B in the gen 4 character table
+	if section[0] != 0x2B || section[1] != 0x01 {
+		t.Fatalf("expected box name to start with 2b 01, got % x\n", section[0:2])
+	}
+
+	if section[consts.BOX_WALLPAPERS+1] != 20 {
+		t.Fatalf("expected wallpaper %d, got %d\n", 20, section[consts.BOX_WALLPAPERS+1])
+	}
+
+	if section[consts.BOX_WALLPAPER_FLAGS] != 0b1_0000 {
+		t.Fatalf("expected flags 0x%x, got 0x%x\n", 0b1_0000, section[consts.BOX_WALLPAPER_FLAGS])
+	}
+}
@@ -0,0 +1,26 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+)
+
+// Imports an .ek4 file into the party, like ImportPartyPokemon does with .pk4 files.
+// The file's checksum must be valid
+func ImportPartyEK4(savefile sav.ISave, partyIndex uint, ek4 []byte) ([]byte, error) {
+	plaintext, err := crypt.Decrypt(ek4, len(ek4))
+	if err != nil {
+		return []byte{}, fmt.Errorf("invalid .ek4 file: %s", err)
+	}
+
+	pk4, err := shuffler.Unshuffle(plaintext, binary.LittleEndian.Uint32(plaintext[0:4]))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return ImportPartyPokemon(savefile, partyIndex, pk4)
+}
@@ -0,0 +1,58 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+)
+
+// brings the level and EXP of a decrypted party pokemon back in line once one of them is written.
+// A LEVEL write resets EXP to the minimum for that level, and an EXPERIENCE write moves the level
+// to match. Otherwise the game would recompute the level from EXP, undoing the LEVEL write.
+// Species introduced in gen 5 have no growth rate in the species table, so they're left as written
+func syncExperience(plaintext []byte, wroteLevel, wroteExp bool) error {
+	if wroteLevel && wroteExp {
+		return fmt.Errorf("LEVEL and EXPERIENCE can't be written to the same pokemon at once")
+	}
+
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	blockA, err := shuffler.GetPokemonBlock(plaintext, shuffler.A, personality)
+	if err != nil {
+		return err
+	}
+
+	species, err := data.GetSpecies(binary.LittleEndian.Uint16(blockA[:2]))
+	if err != nil {
+		return nil
+	}
+
+	battleStats := plaintext[consts.BATTLE_STATS_OFFSET:]
+
+	if wroteExp {
+		exp := uint(binary.LittleEndian.Uint32(blockA[consts.BLOCK_A_EXPERIENCE:]))
+		level, err := data.LevelForExperience(species.GrowthRate, exp)
+		if err != nil {
+			return err
+		}
+
+		battleStats[consts.BATTLE_STATS_LEVEL] = byte(level)
+		return nil
+	}
+
+	exp, err := data.ExperienceForLevel(species.GrowthRate, uint(battleStats[consts.BATTLE_STATS_LEVEL]))
+	if err != nil {
+		return err
+	}
+
+	blockAddress, err := shuffler.GetPokemonBlockLocation(shuffler.A, personality)
+	if err != nil {
+		return err
+	}
+
+	binary.LittleEndian.PutUint32(plaintext[blockAddress+consts.BLOCK_A_EXPERIENCE:], uint32(exp))
+	return nil
+}
@@ -0,0 +1,164 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/pid"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+)
+
+// gives the decrypted pokemon a shiny personality value for its OT
+func makeShiny(plaintext []byte) error {
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+	tid, sid, err := originalTrainer(plaintext)
+	if err != nil {
+		return err
+	}
+
+	shiny, err := pid.MakeShiny(personality, tid, sid)
+	if err != nil {
+		return err
+	}
+
+	return setPersonality(plaintext, shiny)
+}
+
+// TID and SID of the decrypted pokemon's OT, which shininess depends on
+func originalTrainer(plaintext []byte) (tid, sid uint16, err error) {
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	blockA, err := shuffler.GetPokemonBlock(plaintext, shuffler.A, personality)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tid = binary.LittleEndian.Uint16(blockA[consts.BLOCK_A_OT_ID:])
+	sid = binary.LittleEndian.Uint16(blockA[consts.BLOCK_A_OT_SECRET_ID:])
+	return tid, sid, nil
+}
+
+// gives the decrypted gen 4 pokemon a personality value with the requested nature
+func writeNature(plaintext []byte, write req.Writable) error {
+	nature, ok := write.(req.WriteNature)
+	if !ok {
+		return fmt.Errorf("expected a nature write, got %T", write)
+	}
+
+	bytes, err := nature.Bytes()
+	if err != nil {
+		return err
+	}
+
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+	tid, sid, err := originalTrainer(plaintext)
+	if err != nil {
+		return err
+	}
+
+	newPersonality, err := pid.WithNature(personality, uint(bytes[0]), tid, sid, nature.KeepShiny)
+	if err != nil {
+		return err
+	}
+
+	return setPersonality(plaintext, newPersonality)
+}
+
+// checks an ABILITY or GENDER write against the decrypted gen 4 pokemon's species and
+// personality value. FIX_PID writes get a new personality value instead of being rejected
+func reconcilePersonality(plaintext []byte, write req.Writable) error {
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	blockA, err := shuffler.GetPokemonBlock(plaintext, shuffler.A, personality)
+	if err != nil {
+		return err
+	}
+
+	species, err := data.GetSpecies(binary.LittleEndian.Uint16(blockA[:2]))
+	if err != nil {
+		return err
+	}
+
+	tid, sid, err := originalTrainer(plaintext)
+	if err != nil {
+		return err
+	}
+
+	var mode req.PIDMode
+	var trait string
+	var fix func() (uint32, error)
+
+	switch w := write.(type) {
+	case req.WriteAbility:
+		if !species.HasAbility(w.Name) {
+			return fmt.Errorf("%s can't have the ability '%s'", species.Name, w.Name)
+		}
+
+		var slot uint
+		if species.Abilities[1] == w.Name {
+			slot = 1
+		}
+
+		// species with a single ability have it in both slots
+		if species.Abilities[1] == "" || pid.AbilitySlot(personality) == slot {
+			return nil
+		}
+
+		mode, trait = w.Mode, fmt.Sprintf("the ability '%s'", w.Name)
+		fix = func() (uint32, error) {
+			return pid.WithAbilitySlot(personality, species.GenderRatio, slot, tid, sid, true)
+		}
+	case req.WriteGender:
+		current := pid.Gender(personality, species.GenderRatio)
+		if current == w.Name {
+			return nil
+		}
+
+		fixedRatio := species.GenderRatio == data.MALE_ONLY || species.GenderRatio == data.FEMALE_ONLY || species.GenderRatio == data.GENDERLESS
+		if fixedRatio || w.Name == "Genderless" {
+			return fmt.Errorf("%s can't be %s", species.Name, w.Name)
+		}
+
+		mode, trait = w.Mode, w.Name
+		fix = func() (uint32, error) {
+			return pid.WithGender(personality, species.GenderRatio, w.Name, tid, sid, true)
+		}
+	default:
+		return fmt.Errorf("expected an ability or gender write, got %T", write)
+	}
+
+	if mode != req.FIX_PID {
+		return fmt.Errorf("personality value 0x%08X doesn't give %s %s; use FIX_PID to pick a new one", personality, species.Name, trait)
+	}
+
+	newPersonality, err := fix()
+	if err != nil {
+		return err
+	}
+
+	return setPersonality(plaintext, newPersonality)
+}
+
+// the block order is derived from the personality value, so the blocks are moved
+// to where the new one expects them. The checksum is updated on encryption
+func setPersonality(plaintext []byte, personality uint32) error {
+	old := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	canonical, err := shuffler.Unshuffle(plaintext, old)
+	if err != nil {
+		return err
+	}
+
+	binary.LittleEndian.PutUint32(canonical[0:4], personality)
+
+	shuffled, err := shuffler.Shuffle(canonical, personality)
+	if err != nil {
+		return err
+	}
+
+	copy(plaintext, shuffled)
+	return nil
+}
@@ -0,0 +1,57 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+)
+
+// Imports a .pk4 file, in either format, into the party. An index equal to the party size adds
+// the pokemon to the end of the party, and any other index replaces the pokemon there.
+// Box format files get their level from EXP and freshly computed battle stats, at full HP
+func ImportPartyPokemon(savefile sav.ISave, partyIndex uint, pk4 []byte) ([]byte, error) {
+	game, ok := savefile.(sav.IGen4Save)
+	if !ok {
+		return []byte{}, fmt.Errorf(".pk4 files are only supported in gen 4 savefiles")
+	}
+
+	// encryption stamps a fresh checksum, so a damaged file has to be caught here
+	if err := rom_reader.CheckPK4(pk4); err != nil {
+		return []byte{}, err
+	}
+
+	partySize := uint(game.PartySize())
+	if partyIndex > partySize || partyIndex >= 6 {
+		return []byte{}, fmt.Errorf("invalid party index %d", partyIndex)
+	}
+
+	buffer := make([]byte, consts.PARTY_POKEMON_SIZE)
+	copy(buffer, pk4)
+
+	plaintext, err := shuffler.Shuffle(buffer, binary.LittleEndian.Uint32(buffer[0:4]))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if len(pk4) == consts.BOX_POKEMON_SIZE {
+		if err := initBattleStats(savefile, plaintext); err != nil {
+			return []byte{}, err
+		}
+	}
+
+	// the checksum is recomputed as part of encryption
+	offset := partyIndex * consts.PARTY_POKEMON_SIZE
+	copy(game.PartySection()[offset:offset+consts.PARTY_POKEMON_SIZE], crypt.EncryptPokemon(plaintext))
+
+	if partyIndex == partySize {
+		game.SetPartySize(uint32(partySize + 1))
+	}
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
@@ -0,0 +1,138 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+	"github.com/dingdongg/pkmn-rom-parser/v7/stats"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportPartyPokemon(t *testing.T) {
+	game := readPlatinumMock(t)
+	expected := readParty(t, game)[2]
+
+	pk4, err := rom_reader.ExportPartyPokemon(game, 2)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	updated, err := ImportPartyPokemon(game, 0, pk4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	party := readParty(t, revalidate(t, updated))
+	if len(party) != 6 {
+		t.Fatalf("expected party size %d, got %d\n", 6, len(party))
+	}
+
+	if diff := cmp.Diff(expected, party[0]); diff != "" {
+		t.Fatalf("expected the imported pokemon to match the exported one (-want +got):\n%s", diff)
+	}
+}
+
+func TestImportBoxFormat(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	// PSYDUCK
+	pk4, err := rom_reader.ExportBoxPokemon(game, 0, 1)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	updated, err := DepositPokemon(game, 5, 17, 29)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	updated, err = ImportPartyPokemon(revalidate(t, updated), 5, pk4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game = revalidate(t, updated)
+	if game.PartySize() != 6 {
+		t.Fatalf("expected party size %d, got %d\n", 6, game.PartySize())
+	}
+
+	pokemon := readParty(t, game)[5]
+	if pokemon.Species != "Psyduck" || pokemon.Level == 0 {
+		t.Fatalf("expected a leveled Psyduck, but got %+v\n", pokemon)
+	}
+
+	expected, err := stats.Calculate(pokemon.PokedexId, pokemon.IVs, pokemon.EVs, pokemon.Level, pokemon.Nature)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if pokemon.Stats != expected {
+		t.Fatalf("expected %+v, but got %+v\n", expected, pokemon.Stats)
+	}
+
+	plaintext, err := crypt.DecryptPokemon(game.PartySection()[5*consts.PARTY_POKEMON_SIZE:])
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	currentHp := binary.LittleEndian.Uint16(plaintext[consts.BATTLE_STATS_OFFSET+consts.BATTLE_STATS_CURRENT_HP:])
+	if uint(currentHp) != expected.Hp {
+		t.Fatalf("expected %d HP, but got %d\n", expected.Hp, currentHp)
+	}
+}
+
+func TestImportValidation(t *testing.T) {
+	game := readPlatinumMock(t)
+	pk4, _ := rom_reader.ExportPartyPokemon(game, 0)
+
+	if _, err := ImportPartyPokemon(game, 6, pk4); err == nil {
+		t.Fatal("expected an import into a full party to be rejected")
+	}
+
+	if _, err := ImportPartyPokemon(game, 0, pk4[:220]); err == nil {
+		t.Fatal("expected a 220 byte file to be rejected")
+	}
+
+	flipped := make([]byte, len(pk4))
+	copy(flipped, pk4)
+	flipped[0x40] ^= 0xFF
+	if _, err := ImportPartyPokemon(game, 0, flipped); err == nil {
+		t.Fatal("expected a file with a bad checksum to be rejected")
+	}
+
+	// ability 200, with the checksum fixed up to match
+	badAbility := make([]byte, len(pk4))
+	copy(badAbility, pk4)
+	checksum := binary.LittleEndian.Uint16(badAbility[6:]) - uint16(badAbility[0x8+consts.BLOCK_A_ABILITY])<<8 + 200<<8
+	badAbility[0x8+consts.BLOCK_A_ABILITY] = 200
+	binary.LittleEndian.PutUint16(badAbility[6:], checksum)
+	if _, err := ImportPartyPokemon(game, 0, badAbility); err == nil {
+		t.Fatal("expected a file with an invalid ability to be rejected")
+	}
+}
+
+func TestImportPartyEK4(t *testing.T) {
+	game := readPlatinumMock(t)
+	expected := readParty(t, game)[2]
+
+	ek4, err := rom_reader.ExportPartyEK4(game, 2)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	updated, err := ImportPartyEK4(game, 0, ek4)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if diff := cmp.Diff(expected, readParty(t, revalidate(t, updated))[0]); diff != "" {
+		t.Fatalf("expected the imported pokemon to match the exported one (-want +got):\n%s", diff)
+	}
+
+	ek4[0x20] ^= 0xFF
+	if _, err := ImportPartyEK4(readPlatinumMock(t), 0, ek4); err == nil {
+		t.Fatal("expected a corrupted .ek4 file to be rejected")
+	}
+}
@@ -0,0 +1,134 @@
+package rom_writer
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func UpdatePokedex(savefile sav.ISave, newData *req.PokedexWriteRequest) ([]byte, error) {
+	game, ok := savefile.(sav.IGen4Save)
+	if !ok || game.PokedexLayout() == nil {
+		return []byte{}, fmt.Errorf("pokedex data is only supported in gen 4 savefiles")
+	}
+
+	layout := game.PokedexLayout()
+	if err := validatePokedexRequest(layout, newData); err != nil {
+		return []byte{}, err
+	}
+
+	section := game.PokedexSection()
+
+	if newData.NationalDex != nil {
+		section[layout.NationalDexFlag] = 0
+		if *newData.NationalDex {
+			section[layout.NationalDexFlag] = 1
+		}
+	}
+
+	for species, seen := range newData.Seen {
+		if seen {
+			markSeen(section, species)
+			continue
+		}
+
+		sav.SetDexFlag(section, sav.DEX_SEEN, species, false)
+		sav.SetDexFlag(section, sav.DEX_CAUGHT, species, false)
+		sav.SetDexFlag(section, sav.DEX_FIRST_GENDER, species, false)
+		sav.SetDexFlag(section, sav.DEX_SECOND_GENDER, species, false)
+		if forms, ok := layout.Forms[species]; ok {
+			forms.Write(section, []uint{})
+		}
+	}
+
+	for species, caught := range newData.Caught {
+		if caught {
+			markSeen(section, species)
+		}
+		sav.SetDexFlag(section, sav.DEX_CAUGHT, species, caught)
+	}
+
+	for species, genders := range newData.Genders {
+		markSeen(section, species)
+
+		// a single gender is recorded twice
+		second := genders[len(genders)-1]
+		sav.SetDexFlag(section, sav.DEX_FIRST_GENDER, species, genders[0] == "Female")
+		sav.SetDexFlag(section, sav.DEX_SECOND_GENDER, species, second == "Female")
+	}
+
+	for species, forms := range newData.Forms {
+		markSeen(section, species)
+		layout.Forms[species].Write(section, forms)
+	}
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
+
+func markSeen(section []byte, species uint16) {
+	sav.SetDexFlag(section, sav.DEX_SEEN, species, true)
+}
+
+func validatePokedexRequest(layout *sav.PokedexLayout, newData *req.PokedexWriteRequest) error {
+	if newData.NationalDex != nil && layout.NationalDexFlag == 0 {
+		return fmt.Errorf("the national dex flag isn't supported in this savefile")
+	}
+
+	species := make([]uint16, 0)
+	for s := range newData.Seen {
+		species = append(species, s)
+	}
+	for s := range newData.Caught {
+		species = append(species, s)
+	}
+
+	for s, genders := range newData.Genders {
+		species = append(species, s)
+
+		if len(genders) == 0 || len(genders) > 2 {
+			return fmt.Errorf("expected 1 or 2 seen genders for #%d, got %d", s, len(genders))
+		}
+
+		for _, g := range genders {
+			if g != "Male" && g != "Female" {
+				return fmt.Errorf("invalid gender '%s'", g)
+			}
+		}
+	}
+
+	for s, forms := range newData.Forms {
+		species = append(species, s)
+
+		field, ok := layout.Forms[s]
+		if !ok {
+			return fmt.Errorf("forms aren't recorded for #%d", s)
+		}
+
+		if uint(len(forms)) > field.Slots {
+			return fmt.Errorf("can't record more than %d forms for #%d", field.Slots, s)
+		}
+
+		seen := make(map[uint]bool)
+		for _, f := range forms {
+			if f >= field.FormCount() || seen[f] {
+				return fmt.Errorf("invalid form %d for #%d", f, s)
+			}
+			seen[f] = true
+		}
+
+		// both slots would be set, which reads back as no forms seen
+		if field.Bits == 1 && len(forms) == 1 && forms[0] == 1 {
+			return fmt.Errorf("#%d can't be recorded as seen in its second form only", s)
+		}
+	}
+
+	for _, s := range species {
+		if s == 0 || s > sav.DEX_SPECIES {
+			return fmt.Errorf("invalid national dex ID %d", s)
+		}
+	}
+
+	return nil
+}
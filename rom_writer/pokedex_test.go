@@ -0,0 +1,132 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+// blank DP savefile, with valid footers in both chunks
+func mockDPSave(t *testing.T) sav.IGen4Save {
+	savefile := make([]byte, 0x80000)
+
+	for _, chunk := range []uint{0x0, sav.SECOND_CHUNK_OFFSET} {
+		for _, block := range [][2]uint{{0x0, sav.DP_SB_END}, {sav.DP_BB_START, sav.DP_BB_END}} {
+			start, end := chunk+block[0], chunk+block[1]
+			footer := savefile[end-0x14 : end]
+			binary.LittleEndian.PutUint32(footer[0x8:], uint32(block[1]-block[0]))
+			binary.LittleEndian.PutUint32(footer[0xC:], sav.MAGIC_TIMESTAMP_JP_INTL)
+			binary.LittleEndian.PutUint16(footer[0x12:], crypt.CRC16_CCITT(savefile[start:end-0x14]))
+		}
+	}
+
+	return revalidate(t, savefile)
+}
+
+func TestUpdatePokedex(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	pwr := req.NewPokedexWriteRequest()
+	pwr.WriteNationalDex(true)
+	pwr.WriteCaught(492, true)
+	pwr.WriteSeenGenders(492, "Male")
+	pwr.WriteSeenForms(492, 1, 0)
+	pwr.WriteSeen(201, false)
+
+	updated, err := UpdatePokedex(game, pwr)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game = revalidate(t, updated)
+	section := game.PokedexSection()
+	layout := game.PokedexLayout()
+
+	if section[layout.NationalDexFlag] != 1 {
+		t.Fatal("expected the national dex to be unlocked")
+	}
+
+	if !sav.GetDexFlag(section, sav.DEX_SEEN, 492) || !sav.GetDexFlag(section, sav.DEX_CAUGHT, 492) {
+		t.Fatal("expected Shaymin to be seen and caught")
+	}
+
+	if forms := layout.Forms[492].Read(section); len(forms) != 2 || forms[0] != 1 {
+		t.Fatalf("expected Shaymin forms [1 0], got %v\n", forms)
+	}
+
+	if sav.GetDexFlag(section, sav.DEX_SEEN, 201) || len(layout.Forms[201].Read(section)) != 0 {
+		t.Fatal("expected Unown to be cleared")
+	}
+}
+
+func TestUpdatePokedexDP(t *testing.T) {
+	pwr := req.NewPokedexWriteRequest()
+	pwr.WriteCaught(422, true)
+	pwr.WriteSeenGenders(422, "Female", "Male")
+	pwr.WriteSeenForms(422, 1, 0)
+
+	updated, err := UpdatePokedex(mockDPSave(t), pwr)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	pokedex, err := rom_reader.GetPokedex(revalidate(t, updated))
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if pokedex.NationalDex != nil {
+		t.Fatal("expected the DP national dex flag to be reported as unknown")
+	}
+
+	shellos := pokedex.Entries[422-1]
+	if !shellos.Seen || !shellos.Caught || pokedex.SeenCount() != 1 {
+		t.Fatalf("expected only Shellos to be seen and caught, got %+v\n", shellos)
+	}
+
+	if len(shellos.SeenGenders) != 2 || shellos.SeenGenders[0] != "Female" {
+		t.Fatalf("expected Shellos genders [Female Male], got %v\n", shellos.SeenGenders)
+	}
+
+	if len(shellos.SeenForms) != 2 || shellos.SeenForms[0] != 1 {
+		t.Fatalf("expected Shellos forms [1 0], got %v\n", shellos.SeenForms)
+	}
+
+	// the national dex flag's location isn't known in DP
+	pwr = req.NewPokedexWriteRequest()
+	pwr.WriteNationalDex(true)
+	if _, err := UpdatePokedex(mockDPSave(t), pwr); err == nil {
+		t.Fatal("expected national dex write to be rejected")
+	}
+
+	// Rotom forms only exist from Platinum onwards
+	pwr = req.NewPokedexWriteRequest()
+	pwr.WriteSeenForms(479, 0)
+	if _, err := UpdatePokedex(mockDPSave(t), pwr); err == nil {
+		t.Fatal("expected Rotom form write to be rejected")
+	}
+}
+
+func TestUpdatePokedexValidation(t *testing.T) {
+	requests := []func(pwr *req.PokedexWriteRequest){
+		func(pwr *req.PokedexWriteRequest) { pwr.WriteSeen(494, true) },
+		func(pwr *req.PokedexWriteRequest) { pwr.WriteSeenForms(25, 0) },
+		func(pwr *req.PokedexWriteRequest) { pwr.WriteSeenForms(412, 3) },
+		func(pwr *req.PokedexWriteRequest) { pwr.WriteSeenForms(422, 0, 0) },
+		func(pwr *req.PokedexWriteRequest) { pwr.WriteSeenForms(422, 1) },
+		func(pwr *req.PokedexWriteRequest) { pwr.WriteSeenGenders(25, "Other") },
+	}
+
+	for i, r := range requests {
+		pwr := req.NewPokedexWriteRequest()
+		r(pwr)
+
+		if _, err := UpdatePokedex(readPlatinumMock(t), pwr); err == nil {
+			t.Fatalf("expected request %d to be rejected", i)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package req
+
+import "github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+
+func NewBagWriteRequest() *BagWriteRequest {
+	return &BagWriteRequest{
+		make([]BagItemWrite, 0),
+	}
+}
+
+// adds the item to the pocket, or re-counts it if it's already there
+func (bwr *BagWriteRequest) SetItem(p pocket.Pocket, itemName string, quantity uint) {
+	bwr.Items = append(bwr.Items, BagItemWrite{p, itemName, quantity})
+}
+
+func (bwr *BagWriteRequest) RemoveItem(p pocket.Pocket, itemName string) {
+	bwr.SetItem(p, itemName, 0)
+}
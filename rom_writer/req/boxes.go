@@ -0,0 +1,45 @@
+package req
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+)
+
+func NewBoxesWriteRequest() BoxesWriteRequest {
+	return BoxesWriteRequest{
+		make(map[uint]Writable),
+		make(map[uint]Writable),
+		make(map[uint]bool),
+	}
+}
+
+func (bwr BoxesWriteRequest) WriteBoxName(box uint, name string) {
+	bwr.Names[box] = WriteBoxName{name}
+}
+
+func (bwr BoxesWriteRequest) WriteWallpaper(box uint, wallpaper uint) {
+	bwr.Wallpapers[box] = WriteWallpaper{wallpaper}
+}
+
+// special wallpapers are indexed from 0, which is wallpaper 16
+func (bwr BoxesWriteRequest) UnlockWallpaper(special uint, unlocked bool) {
+	bwr.UnlockedWallpapers[special] = unlocked
+}
+
+// box names are 8 characters max (so 9 with the terminator)
+func (wbn WriteBoxName) Bytes() ([]byte, error) {
+	if len([]rune(wbn.Val)) > consts.BOX_NAME_MAX_LENGTH {
+		return []byte{}, fmt.Errorf("box name can only be max %d characters long", consts.BOX_NAME_MAX_LENGTH)
+	}
+
+	return encodeString(wbn.Val, consts.BOX_NAME_SIZE)
+}
+
+func (ww WriteWallpaper) Bytes() ([]byte, error) {
+	if ww.Val >= consts.REGULAR_WALLPAPERS+consts.SPECIAL_WALLPAPERS {
+		return []byte{}, fmt.Errorf("wallpaper must be < %d", consts.REGULAR_WALLPAPERS+consts.SPECIAL_WALLPAPERS)
+	}
+
+	return []byte{byte(ww.Val)}, nil
+}
@@ -0,0 +1,31 @@
+package req
+
+import "testing"
+
+func TestWriteBoxName(t *testing.T) {
+	bwr := NewBoxesWriteRequest()
+
+	bwr.WriteBoxName(0, "ABCDEFGH")
+	byteForm, err := bwr.Names[0].Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(byteForm) != 40 {
+		t.Fatalf(templates.Int, 40, len(byteForm))
+	}
+
+	bwr.WriteBoxName(0, "ABCDEFGHI")
+	if _, err := bwr.Names[0].Bytes(); err == nil {
+		t.Fatal("expected 9 character box name to be rejected")
+	}
+}
+
+func TestWriteWallpaper(t *testing.T) {
+	bwr := NewBoxesWriteRequest()
+
+	bwr.WriteWallpaper(0, 24)
+	if _, err := bwr.Wallpapers[0].Bytes(); err == nil {
+		t.Fatal("expected wallpaper 24 to be rejected")
+	}
+}
@@ -18,13 +18,22 @@ func (wr WriteRequest) WriteItem(itemName string) {
 	wr.Contents[ITEM] = WriteUint{item.Index, 2}
 }
 
+// in gen 4 games, the species must be able to have the ability, and the personality
+// value must pick it. Otherwise the write is rejected, see WriteAbilityWithMode
 func (wr WriteRequest) WriteAbility(ability string) {
-	abilityMap := data.GenerateAbilityMap()
-	abilityId, ok := abilityMap[ability]
-	if !ok {
-		log.Fatalf("failed to write ability '%s'; doesn't exist\n", ability)
-	}
-	wr.Contents[ABILITY] = WriteUint{abilityId, 1}
+	wr.WriteAbilityWithMode(ability, CHECK_PID)
+}
+
+// like WriteAbility, but mode decides what happens when the personality value picks the
+// species' other ability
+func (wr WriteRequest) WriteAbilityWithMode(ability string, mode PIDMode) {
+	wr.Contents[ABILITY] = WriteAbility{ability, mode}
+}
+
+// in gen 4 games, the species' gender ratio must allow the gender, and mode decides what
+// happens when the personality value implies the other one
+func (wr WriteRequest) WriteGender(gender string, mode PIDMode) {
+	wr.Contents[GENDER] = WriteGender{gender, mode}
 }
 
 // TODO improve signature. since golang doesn't does support struct spreading like JS, it's
@@ -45,10 +54,77 @@ func (wr WriteRequest) WriteNickname(name string) {
 	wr.Contents[NICKNAME] = WriteString{name}
 }
 
+// party pokemon also have their EXP set to the minimum for the new level
 func (wr WriteRequest) WriteLevel(level uint) {
 	wr.Contents[LEVEL] = WriteUint{level, 1}
 }
 
+// party pokemon also have their level derived from the new EXP
+func (wr WriteRequest) WriteExperience(exp uint) {
+	wr.Contents[EXPERIENCE] = WriteUint{exp, 4}
+}
+
+// a nature written in the same request keeps the pokemon shiny, whichever is called first
+func (wr WriteRequest) MakeShiny() {
+	wr.Contents[SHINY] = WriteShiny{}
+
+	if wn, ok := wr.Contents[NATURE].(WriteNature); ok {
+		wn.KeepShiny = true
+		wr.Contents[NATURE] = wn
+	}
+}
+
+// the new personality value depends on the pokemon's OT, so there's nothing to copy over
+func (ws WriteShiny) Bytes() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// a new personality value is picked for gen 4 pokemon, so shininess is only kept if keepShiny is set
+func (wr WriteRequest) WriteNature(nature string, keepShiny bool) {
+	_, shiny := wr.Contents[SHINY]
+	wr.Contents[NATURE] = WriteNature{nature, keepShiny || shiny}
+}
+
+// the nature's index, as stored by gen 5 games
+func (wn WriteNature) Bytes() ([]byte, error) {
+	index, ok := data.GenerateNatureIndexMap()[wn.Name]
+	if !ok {
+		return []byte{}, fmt.Errorf("nature '%s' doesn't exist", wn.Name)
+	}
+
+	return []byte{byte(index)}, nil
+}
+
+func (wa WriteAbility) Bytes() ([]byte, error) {
+	abilityId, ok := data.GenerateAbilityMap()[wa.Name]
+	if !ok {
+		return []byte{}, fmt.Errorf("ability '%s' doesn't exist", wa.Name)
+	}
+
+	return []byte{byte(abilityId)}, nil
+}
+
+// genderless takes precedence over the female flag, so only one is ever set
+var genderFlags = map[string]byte{
+	"Male":       0,
+	"Female":     0b010,
+	"Genderless": 0b100,
+}
+
+func (wg WriteGender) Bytes() ([]byte, error) {
+	flags, ok := genderFlags[wg.Name]
+	if !ok {
+		return []byte{}, fmt.Errorf("invalid gender '%s'", wg.Name)
+	}
+
+	return []byte{flags}, nil
+}
+
+// the fateful encounter flag and form share the byte
+func (wg WriteGender) Mask() []byte {
+	return []byte{0b110}
+}
+
 func (ws WriteStats) Bytes() ([]byte, error) {
 	res := make([]byte, 0)
 	stats := [6]uint{ws.Hp, ws.Attack, ws.Defense, ws.Speed, ws.SpAttack, ws.SpDefense}
@@ -140,3 +216,29 @@ func (ws WriteString) Bytes() ([]byte, error) {
 
 	return res, nil
 }
+
+// gen 5 strings are UTF-16, but keep the same length limit and terminator as gen 4
+func (ws WriteString) Gen5Bytes() ([]byte, error) {
+	if len([]rune(ws.Val)) > 10 {
+		return []byte{}, fmt.Errorf("string can only be max 10 characters long")
+	}
+
+	res := make([]byte, 0)
+
+	for _, r := range ws.Val {
+		index, err := char.IndexGen5(string(r))
+		if err != nil {
+			return []byte{}, err
+		}
+
+		res = binary.LittleEndian.AppendUint16(res, index)
+	}
+
+	res = append(res, 0xFF, 0xFF)
+
+	for len(res) != 22 {
+		res = append(res, 0x0)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,66 @@
+package req
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+)
+
+// replaces all 4 move slots. Slots past the given moves are emptied
+func (wr WriteRequest) WriteMoves(moves ...string) {
+	wr.Contents[MOVES] = WriteMoves{moves}
+}
+
+// moves, PP and PP Ups are laid out back to back, so they are written together.
+// PP Ups belonged to the replaced moves, so they are cleared and PP is refilled
+// to the new moves' base PP
+func (wm WriteMoves) Bytes() ([]byte, error) {
+	if len(wm.Names) == 0 || len(wm.Names) > 4 {
+		return []byte{}, fmt.Errorf("a pokemon must know between 1 and 4 moves")
+	}
+
+	moveMap := data.GenerateMoveMap()
+	moves := make([]byte, 0, 8)
+	pp := make([]byte, 4)
+	seen := make(map[uint16]bool)
+
+	for i, name := range wm.Names {
+		if name == "" {
+			if i == 0 {
+				return []byte{}, fmt.Errorf("the first move slot can't be empty")
+			}
+			moves = binary.LittleEndian.AppendUint16(moves, 0)
+			continue
+		}
+
+		if i > 0 && wm.Names[i-1] == "" {
+			return []byte{}, fmt.Errorf("moves must fill the slots in order, without gaps")
+		}
+
+		id, ok := moveMap[name]
+		if !ok {
+			return []byte{}, fmt.Errorf("move '%s' doesn't exist", name)
+		}
+
+		if seen[id] {
+			return []byte{}, fmt.Errorf("move '%s' is known twice", name)
+		}
+		seen[id] = true
+
+		maxPP, err := data.MaxPP(id, 0)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		moves = binary.LittleEndian.AppendUint16(moves, id)
+		pp[i] = byte(maxPP)
+	}
+
+	for len(moves) < 8 {
+		moves = append(moves, 0x0)
+	}
+
+	res := append(moves, pp...)
+	return append(res, 0, 0, 0, 0), nil
+}
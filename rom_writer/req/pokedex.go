@@ -0,0 +1,34 @@
+package req
+
+func NewPokedexWriteRequest() *PokedexWriteRequest {
+	return &PokedexWriteRequest{
+		nil,
+		make(map[uint16]bool),
+		make(map[uint16]bool),
+		make(map[uint16][]string),
+		make(map[uint16][]uint),
+	}
+}
+
+func (pwr *PokedexWriteRequest) WriteNationalDex(unlocked bool) {
+	pwr.NationalDex = &unlocked
+}
+
+// clearing the seen flag clears every other flag of the species too
+func (pwr *PokedexWriteRequest) WriteSeen(species uint16, seen bool) {
+	pwr.Seen[species] = seen
+}
+
+// caught species are marked as seen too
+func (pwr *PokedexWriteRequest) WriteCaught(species uint16, caught bool) {
+	pwr.Caught[species] = caught
+}
+
+// "Male" or "Female". Genderless species are recorded as male
+func (pwr *PokedexWriteRequest) WriteSeenGenders(species uint16, genders ...string) {
+	pwr.Genders[species] = genders
+}
+
+func (pwr *PokedexWriteRequest) WriteSeenForms(species uint16, forms ...uint) {
+	pwr.Forms[species] = forms
+}
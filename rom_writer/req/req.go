@@ -15,16 +15,43 @@ const (
 	NICKNAME     = "NICKNAME"
 	LEVEL        = "LEVEL"
 	BATTLE_STATS = "BATTLE_STATS"
+	MOVES        = "MOVES"
+	EXPERIENCE   = "EXPERIENCE"
+	SHINY        = "SHINY"
+	NATURE       = "NATURE"
+	GENDER       = "GENDER"
+)
+
+// how ABILITY and GENDER writes are reconciled with the personality value, which
+// decides both in gen 4 games. Gen 5 games store them as-is
+type PIDMode uint
+
+const (
+	CHECK_PID PIDMode = iota // rejects writes the personality value disagrees with
+	FIX_PID                  // picks a new personality value that agrees, keeping the nature and shininess
 )
 
 func NewWriteRequest(partyIndex uint) WriteRequest {
 	return WriteRequest{
 		partyIndex,
 		make(NewData),
+		false,
 	}
 }
 
-// blockIndex will be -1 if the request is invalid, or is a level/battle stat request
+// box pokemon don't store battle stats, so LEVEL and BATTLE_STATS requests are rejected when written
+func NewBoxWriteRequest(box, slot uint) BoxWriteRequest {
+	return BoxWriteRequest{
+		box,
+		slot,
+		NewWriteRequest(0),
+	}
+}
+
+// blockIndex will be -1 if the request is invalid, or is a level/battle stat request.
+// SHINY requests change the personality value, so they have no fixed location and are
+// applied by the writer instead. So do NATURE requests, except in gen 5 games, which
+// store the nature in block B
 func GetWriteLocation(request string) (dataOffset int, blockIndex int, err error) {
 	if request == ITEM {
 		dataOffset = consts.BLOCK_A_ITEM
@@ -35,9 +62,21 @@ func GetWriteLocation(request string) (dataOffset int, blockIndex int, err error
 	} else if request == EV {
 		dataOffset = consts.BLOCK_A_EV
 		blockIndex = shuffler.A
+	} else if request == EXPERIENCE {
+		dataOffset = consts.BLOCK_A_EXPERIENCE
+		blockIndex = shuffler.A
 	} else if request == IV {
 		dataOffset = consts.BLOCK_B_IV
 		blockIndex = shuffler.B
+	} else if request == MOVES {
+		dataOffset = consts.BLOCK_B_MOVES
+		blockIndex = shuffler.B
+	} else if request == NATURE {
+		dataOffset = consts.BLOCK_B_NATURE_GEN5
+		blockIndex = shuffler.B
+	} else if request == GENDER {
+		dataOffset = consts.BLOCK_B_FLAGS
+		blockIndex = shuffler.B
 	} else if request == NICKNAME {
 		dataOffset = consts.BLOCK_C_NICKNAME
 		blockIndex = shuffler.C
@@ -53,3 +92,35 @@ func GetWriteLocation(request string) (dataOffset int, blockIndex int, err error
 
 	return dataOffset, blockIndex, nil
 }
+
+const (
+	TRAINER_NAME = "TRAINER_NAME"
+	MONEY        = "MONEY"
+	BADGES       = "BADGES"
+	KANTO_BADGES = "KANTO_BADGES"
+	PLAY_TIME    = "PLAY_TIME"
+)
+
+func NewTrainerWriteRequest() TrainerWriteRequest {
+	return TrainerWriteRequest{
+		make(NewData),
+	}
+}
+
+// dataOffset is relative to the start of the trainer section
+func GetTrainerWriteLocation(request string) (dataOffset int, err error) {
+	switch request {
+	case TRAINER_NAME:
+		return consts.TRAINER_NAME, nil
+	case MONEY:
+		return consts.TRAINER_MONEY, nil
+	case BADGES:
+		return consts.TRAINER_BADGES, nil
+	case KANTO_BADGES:
+		return consts.TRAINER_BADGES_KANTO, nil
+	case PLAY_TIME:
+		return consts.TRAINER_PLAY_HOURS, nil
+	}
+
+	return -1, fmt.Errorf("invalid trainer write request '%s'", request)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/dingdongg/pkmn-rom-parser/v7/char"
 	"github.com/dingdongg/pkmn-rom-parser/v7/tutil"
+	"github.com/google/go-cmp/cmp"
 )
 
 var templates = tutil.GetTemplates()
@@ -224,3 +225,103 @@ func TestWriteNickname(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteMoves(t *testing.T) {
+	wr := NewWriteRequest(0)
+	wr.WriteMoves("Night Slash", "Ice Shard")
+
+	byteForm, err := wr.Contents[MOVES].Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	// 4 move IDs, then their PP, then their PP Ups
+	expected := []byte{
+		0x90, 0x01, 0xA4, 0x01, 0x00, 0x00, 0x00, 0x00,
+		15, 30, 0, 0,
+		0, 0, 0, 0,
+	}
+
+	if !cmp.Equal(byteForm, expected) {
+		t.Fatalf("expected % x, but got % x\n", expected, byteForm)
+	}
+}
+
+func TestWriteMovesValidation(t *testing.T) {
+	cases := [][]string{
+		{},
+		{"Tackle", "Growl", "Ember", "Leer", "Scratch"},
+		{"Not A Move"},
+		{"Tackle", "Tackle"},
+		{"", "Tackle"},
+		{"Tackle", "", "Growl"},
+	}
+
+	for _, moves := range cases {
+		wr := NewWriteRequest(0)
+		wr.WriteMoves(moves...)
+
+		if _, err := wr.Contents[MOVES].Bytes(); err == nil {
+			t.Fatalf("expected moves %v to be rejected\n", moves)
+		}
+	}
+}
+
+func TestWriteNature(t *testing.T) {
+	wr := NewWriteRequest(0)
+	wr.WriteNature("Modest", false)
+
+	byteForm, err := wr.Contents[NATURE].Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if !cmp.Equal(byteForm, []byte{15}) {
+		t.Fatalf("expected % x, but got % x\n", []byte{15}, byteForm)
+	}
+
+	wr.WriteNature("Not A Nature", false)
+	if _, err := wr.Contents[NATURE].Bytes(); err == nil {
+		t.Fatal("expected an unknown nature to be rejected")
+	}
+}
+
+func TestWriteNatureKeepsShiny(t *testing.T) {
+	before := NewWriteRequest(0)
+	before.WriteNature("Modest", false)
+	before.MakeShiny()
+
+	after := NewWriteRequest(0)
+	after.MakeShiny()
+	after.WriteNature("Modest", false)
+
+	for _, wr := range []WriteRequest{before, after} {
+		if !wr.Contents[NATURE].(WriteNature).KeepShiny {
+			t.Fatal("expected a nature written alongside MakeShiny to keep the pokemon shiny")
+		}
+	}
+}
+
+func TestWriteGender(t *testing.T) {
+	wr := NewWriteRequest(0)
+	wr.WriteGender("Female", FIX_PID)
+
+	res := wr.Contents[GENDER]
+	byteForm, err := res.Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if !cmp.Equal(byteForm, []byte{0b010}) {
+		t.Fatalf("expected % x, but got % x\n", []byte{0b010}, byteForm)
+	}
+
+	if !cmp.Equal(res.(Maskable).Mask(), []byte{0b110}) {
+		t.Fatalf("expected % x, but got % x\n", []byte{0b110}, res.(Maskable).Mask())
+	}
+
+	wr.WriteGender("Unknown", FIX_PID)
+	if _, err := wr.Contents[GENDER].Bytes(); err == nil {
+		t.Fatal("expected an unknown gender to be rejected")
+	}
+}
@@ -0,0 +1,114 @@
+package req
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/char"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+)
+
+func (twr TrainerWriteRequest) WriteName(name string) {
+	twr.Contents[TRAINER_NAME] = WriteTrainerName{name}
+}
+
+func (twr TrainerWriteRequest) WriteMoney(money uint) {
+	twr.Contents[MONEY] = WriteMoney{money}
+}
+
+// badges are indexed in the order the gym leaders are meant to be challenged
+func (twr TrainerWriteRequest) WriteBadge(index uint, earned bool) {
+	twr.Contents[BADGES] = toggleBadge(twr.Contents[BADGES], index, earned)
+}
+
+// HGSS only
+func (twr TrainerWriteRequest) WriteKantoBadge(index uint, earned bool) {
+	twr.Contents[KANTO_BADGES] = toggleBadge(twr.Contents[KANTO_BADGES], index, earned)
+}
+
+func (twr TrainerWriteRequest) WritePlayTime(hours, minutes, seconds uint) {
+	twr.Contents[PLAY_TIME] = WritePlayTime{hours, minutes, seconds}
+}
+
+// merges the toggle with the ones previously requested
+func toggleBadge(previous Writable, index uint, earned bool) WriteBadges {
+	badges, _ := previous.(WriteBadges)
+	if index >= 8 {
+		badges.invalid = true
+		return badges
+	}
+
+	bit := uint8(1 << index)
+	badges.Toggled |= bit
+	badges.Earned &^= bit
+	if earned {
+		badges.Earned |= bit
+	}
+
+	return badges
+}
+
+// trainer names are 7 characters max (so 8 with the terminator)
+func (wtn WriteTrainerName) Bytes() ([]byte, error) {
+	if len([]rune(wtn.Val)) > 7 {
+		return []byte{}, fmt.Errorf("trainer name can only be max 7 characters long")
+	}
+
+	return encodeString(wtn.Val, consts.TRAINER_NAME_SIZE)
+}
+
+// encodes the string with the gen 4 character table, followed by the terminator.
+// The rest of the buffer is zeroed out
+func encodeString(val string, size int) ([]byte, error) {
+	res := make([]byte, 0)
+
+	for _, r := range val {
+		index, err := char.Index(string(r))
+		if err != nil {
+			return []byte{}, err
+		}
+
+		res = binary.LittleEndian.AppendUint16(res, index)
+	}
+
+	res = append(res, 0xFF, 0xFF)
+
+	for len(res) < size {
+		res = append(res, 0x0)
+	}
+
+	return res, nil
+}
+
+func (wm WriteMoney) Bytes() ([]byte, error) {
+	if wm.Val > consts.MAX_MONEY {
+		return []byte{}, fmt.Errorf("money must be <= %d", consts.MAX_MONEY)
+	}
+
+	return binary.LittleEndian.AppendUint32(nil, uint32(wm.Val)), nil
+}
+
+func (wb WriteBadges) Bytes() ([]byte, error) {
+	if wb.invalid {
+		return []byte{}, fmt.Errorf("badge index must be < 8")
+	}
+
+	return []byte{wb.Earned}, nil
+}
+
+func (wb WriteBadges) Mask() []byte {
+	return []byte{wb.Toggled}
+}
+
+func (wpt WritePlayTime) Bytes() ([]byte, error) {
+	if wpt.Hours > consts.MAX_PLAY_HOURS {
+		return []byte{}, fmt.Errorf("play time hours must be <= %d", consts.MAX_PLAY_HOURS)
+	}
+
+	if wpt.Minutes > 59 || wpt.Seconds > 59 {
+		return []byte{}, fmt.Errorf("play time minutes and seconds must be <= 59")
+	}
+
+	res := binary.LittleEndian.AppendUint16(nil, uint16(wpt.Hours))
+	return append(res, byte(wpt.Minutes), byte(wpt.Seconds)), nil
+}
@@ -0,0 +1,96 @@
+package req
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteTrainerName(t *testing.T) {
+	twr := NewTrainerWriteRequest()
+	twr.WriteName("ABC")
+
+	byteForm, err := twr.Contents[TRAINER_NAME].Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if len(byteForm) != 16 {
+		t.Fatalf(templates.Int, 16, len(byteForm))
+	}
+
+	// 'A' is 0x12B in the gen 4 character table, followed by the terminator
+	expected := []byte{0x2B, 0x01, 0x2C, 0x01, 0x2D, 0x01, 0xFF, 0xFF}
+	if !bytes.Equal(byteForm[:8], expected) {
+		t.Fatalf("expected % x but got % x\n", expected, byteForm[:8])
+	}
+}
+
+func TestWriteTrainerNameTooLong(t *testing.T) {
+	twr := NewTrainerWriteRequest()
+	twr.WriteName("ABCDEFGH")
+
+	if _, err := twr.Contents[TRAINER_NAME].Bytes(); err == nil {
+		t.Fatal("expected 8 character name to be rejected")
+	}
+}
+
+func TestWriteMoney(t *testing.T) {
+	twr := NewTrainerWriteRequest()
+
+	twr.WriteMoney(999999)
+	if _, err := twr.Contents[MONEY].Bytes(); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	twr.WriteMoney(1000000)
+	if _, err := twr.Contents[MONEY].Bytes(); err == nil {
+		t.Fatal("expected money above the in-game maximum to be rejected")
+	}
+}
+
+func TestWriteBadge(t *testing.T) {
+	twr := NewTrainerWriteRequest()
+	twr.WriteBadge(0, true)
+	twr.WriteBadge(3, false)
+	twr.WriteBadge(7, true)
+
+	res := twr.Contents[BADGES]
+	byteForm, err := res.Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if byteForm[0] != 0b1000_0001 {
+		t.Fatalf(templates.UintHex, 0b1000_0001, byteForm[0])
+	}
+
+	mask := res.(Maskable).Mask()
+	if mask[0] != 0b1000_1001 {
+		t.Fatalf(templates.UintHex, 0b1000_1001, mask[0])
+	}
+
+	twr.WriteBadge(8, true)
+	if _, err := twr.Contents[BADGES].Bytes(); err == nil {
+		t.Fatal("expected badge index 8 to be rejected")
+	}
+}
+
+func TestWritePlayTime(t *testing.T) {
+	twr := NewTrainerWriteRequest()
+
+	twr.WritePlayTime(999, 59, 59)
+	byteForm, err := twr.Contents[PLAY_TIME].Bytes()
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	expected := []byte{0xE7, 0x03, 59, 59}
+	if !bytes.Equal(byteForm, expected) {
+		t.Fatalf("expected % x but got % x\n", expected, byteForm)
+	}
+
+	twr.WritePlayTime(12, 60, 0)
+	if _, err := twr.Contents[PLAY_TIME].Bytes(); err == nil {
+		t.Fatal("expected 60 minutes to be rejected")
+	}
+}
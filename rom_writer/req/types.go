@@ -1,9 +1,22 @@
 package req
 
+import "github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+
 type Writable interface {
 	Bytes() ([]byte, error)
 }
 
+// implemented by Writables that are encoded differently in gen 5 games
+type Gen5Writable interface {
+	Gen5Bytes() ([]byte, error)
+}
+
+// implemented by Writables that only overwrite some bits of the existing data.
+// Bits outside of the mask are preserved
+type Maskable interface {
+	Mask() []byte
+}
+
 // for battle stats. implements Writable
 type WriteStats struct {
 	Hp        uint
@@ -27,14 +40,119 @@ type WriteUint struct {
 	NumBytes uint	// number of bytes. Used in Bytes() implementation
 }
 
-// for nicknames. implements Writable
+// for nicknames. implements Writable, Gen5Writable
 type WriteString struct {
 	Val string
 }
 
+// picks a new shiny personality value, keeping the nature, gender and ability.
+// implements Writable
+type WriteShiny struct{}
+
+// picks a new personality value with the given nature, keeping the gender and ability.
+// KeepShiny also keeps the pokemon shiny (or not). implements Writable
+type WriteNature struct {
+	Name      string
+	KeepShiny bool
+}
+
+// for abilities, by name. implements Writable
+type WriteAbility struct {
+	Name string
+	Mode PIDMode
+}
+
+// "Male", "Female" or "Genderless". implements Writable, Maskable
+type WriteGender struct {
+	Name string
+	Mode PIDMode
+}
+
+// for move slots, by name. Empty names are empty slots. implements Writable
+type WriteMoves struct {
+	Names []string
+}
+
 type NewData map[string]Writable
 
+// EV, IV, LEVEL and EXPERIENCE writes recompute the party pokemon's battle stats, unless
+// KeepBattleStats is set or the request writes BATTLE_STATS itself
 type WriteRequest struct {
-	PartyIndex uint
-	Contents   NewData
-}
\ No newline at end of file
+	PartyIndex      uint
+	Contents        NewData
+	KeepBattleStats bool
+}
+
+// targets a PC box slot instead of a party index. Box and Slot are 0-indexed
+type BoxWriteRequest struct {
+	Box  uint
+	Slot uint
+	WriteRequest
+}
+
+// for trainer names. implements Writable
+type WriteTrainerName struct {
+	Val string
+}
+
+// for money. implements Writable
+type WriteMoney struct {
+	Val uint
+}
+
+// for badge toggles, one bit per badge. implements Writable, Maskable
+type WriteBadges struct {
+	Earned  uint8
+	Toggled uint8
+	invalid bool // set when a badge index is out of range
+}
+
+// implements Writable
+type WritePlayTime struct {
+	Hours   uint
+	Minutes uint
+	Seconds uint
+}
+
+type TrainerWriteRequest struct {
+	Contents NewData
+}
+
+// for box names. implements Writable
+type WriteBoxName struct {
+	Val string
+}
+
+// for box wallpapers. implements Writable
+type WriteWallpaper struct {
+	Val uint
+}
+
+// maps box indexes to their new name/wallpaper, and special wallpaper indexes to their unlock flag
+type BoxesWriteRequest struct {
+	Names              map[uint]Writable
+	Wallpapers         map[uint]Writable
+	UnlockedWallpapers map[uint]bool
+}
+
+// a quantity of 0 removes the item from the pocket
+type BagItemWrite struct {
+	Pocket   pocket.Pocket
+	Item     string
+	Quantity uint
+}
+
+// items are applied in order. New items are added after the ones already in the pocket
+type BagWriteRequest struct {
+	Items []BagItemWrite
+}
+
+// maps national dex IDs to their new flags. Genders and forms replace the ones
+// already recorded, and are listed in the order they were seen
+type PokedexWriteRequest struct {
+	NationalDex *bool
+	Seen        map[uint16]bool
+	Caught      map[uint16]bool
+	Genders     map[uint16][]string
+	Forms       map[uint16][]uint
+}
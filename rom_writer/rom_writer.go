@@ -70,45 +70,31 @@ func (wrb *WriteRequestBuilder) AddRequest(partyIndex uint) (req.WriteRequest, e
 	return request, nil
 }
 
-// TODO: update function to use ISave methods instead
 func UpdatePartyPokemon(savefile sav.ISave, newData []req.WriteRequest) ([]byte, error) {
 	updatedPokemonIndexes := make(map[uint]bool, 0)
 
-	latestChunk := savefile.LatestData()
-	base := latestChunk.SmallBlock.Address + savefile.PartyOffset()
+	party := savefile.PartySection()
+	pokemonSize := savefile.PartyPokemonSize()
 	changes := make(StagingMap)
+	// level/EXP and battle stats are synced once all of a pokemon's writes are applied
+	syncs := make(map[uint]pendingSync)
 
 	for _, wr := range newData {
-		for request, data := range wr.Contents {
-			bytes, err := data.Bytes()
-			if err != nil {
-				return []byte{}, err
-			}
-
-			offset := base + wr.PartyIndex*consts.PARTY_POKEMON_SIZE
-			personality := binary.LittleEndian.Uint32(savefile.Get(offset, 4))
-
-			dataOffset, blockIndex, err := req.GetWriteLocation(request)
-			if err != nil {
-				return []byte{}, err
-			}
+		syncs[wr.PartyIndex] = syncs[wr.PartyIndex].add(wr)
 
-			var blockAddress uint = 0x88
+		for request, data := range wr.Contents {
+			offset := wr.PartyIndex * pokemonSize
 
-			if blockIndex != -1 {
-				blockAddress, err = shuffler.GetPokemonBlockLocation(uint(blockIndex), personality)
+			if _, ok := changes[wr.PartyIndex]; !ok {
+				plaintext, err := crypt.Decrypt(party[offset:], int(pokemonSize))
 				if err != nil {
-					return []byte{}, err
+					return []byte{}, fmt.Errorf("party pokemon %d: %s", wr.PartyIndex, err)
 				}
+				changes[wr.PartyIndex] = plaintext
 			}
 
-			if _, ok := changes[wr.PartyIndex]; !ok {
-				encryptedPokemon := savefile.Get(offset, consts.PARTY_POKEMON_SIZE)
-				changes[wr.PartyIndex] = crypt.DecryptPokemon(encryptedPokemon)
-			}
-			size := copy(changes[wr.PartyIndex][blockAddress+uint(dataOffset):], bytes)
-			if size != len(bytes) {
-				return []byte{}, fmt.Errorf("possible buffer overflow: %d bytes actually copied, expected %d bytes to be copied", size, len(bytes))
+			if err := writeField(savefile, changes[wr.PartyIndex], request, data); err != nil {
+				return []byte{}, err
 			}
 
 			if _, seen := updatedPokemonIndexes[wr.PartyIndex]; !seen {
@@ -118,22 +104,83 @@ func UpdatePartyPokemon(savefile sav.ISave, newData []req.WriteRequest) ([]byte,
 	}
 
 	for i := range updatedPokemonIndexes {
-		pokemonOffset := base + i*consts.PARTY_POKEMON_SIZE
-		encrypted := crypt.EncryptPokemon(changes[i])
+		if err := syncs[i].apply(savefile, changes[i]); err != nil {
+			return []byte{}, err
+		}
+	}
+
+	for i := range updatedPokemonIndexes {
+		pokemonOffset := i * pokemonSize
+		encrypted, err := crypt.Encrypt(changes[i], int(pokemonSize))
+		if err != nil {
+			return []byte{}, err
+		}
 
-		copy(savefile.Get(pokemonOffset, consts.PARTY_POKEMON_SIZE), encrypted)
+		copy(party[pokemonOffset:pokemonOffset+pokemonSize], encrypted)
 	}
 
-	updateBlockChecksum(savefile)
+	savefile.UpdateChecksums()
 	return savefile.Data(), nil
 }
 
-func updateBlockChecksum(savefile sav.ISave) {
-	chunk := savefile.LatestData()
-	newChecksum := crypt.CRC16_CCITT(chunk.SmallBlock.BlockData)
+// writes the requested field into the decrypted pokemon
+func writeField(savefile sav.ISave, plaintext []byte, request string, data req.Writable) error {
+	// changing the personality value moves every block, so there's no fixed location to write to
+	if request == req.SHINY {
+		return makeShiny(plaintext)
+	}
+
+	if request == req.NATURE && !savefile.Version().IsGen5() {
+		return writeNature(plaintext, data)
+	}
+
+	bytes, err := encode(savefile, data)
+	if err != nil {
+		return err
+	}
+
+	if (request == req.ABILITY || request == req.GENDER) && !savefile.Version().IsGen5() {
+		if err := reconcilePersonality(plaintext, data); err != nil {
+			return err
+		}
+	}
+
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	dataOffset, blockIndex, err := req.GetWriteLocation(request)
+	if err != nil {
+		return err
+	}
+
+	var blockAddress uint = consts.BATTLE_STATS_OFFSET
+
+	if blockIndex != -1 {
+		blockAddress, err = shuffler.GetPokemonBlockLocation(uint(blockIndex), personality)
+		if err != nil {
+			return err
+		}
+	}
+
+	// bits outside of the mask keep their current value
+	if maskable, ok := data.(req.Maskable); ok {
+		mask := maskable.Mask()
+		for i := range bytes {
+			bytes[i] = plaintext[blockAddress+uint(dataOffset)+uint(i)]&^mask[i] | bytes[i]&mask[i]
+		}
+	}
 
-	start := chunk.SmallBlock.Address + uint(chunk.SmallBlock.Footer.BlockSize) - 0x14
-	binary.LittleEndian.PutUint16(savefile.Get(start+0x12, 2), newChecksum)
+	size := copy(plaintext[blockAddress+uint(dataOffset):], bytes)
+	if size != len(bytes) {
+		return fmt.Errorf("possible buffer overflow: %d bytes actually copied, expected %d bytes to be copied", size, len(bytes))
+	}
+
+	return nil
+}
+
+func encode(savefile sav.ISave, data req.Writable) ([]byte, error) {
+	if gen5Data, ok := data.(req.Gen5Writable); ok && savefile.Version().IsGen5() {
+		return gen5Data.Gen5Bytes()
+	}
 
-	fmt.Println(savefile.LatestData())
+	return data.Bytes()
 }
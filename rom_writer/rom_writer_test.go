@@ -0,0 +1,371 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+	"github.com/dingdongg/pkmn-rom-parser/v7/stats"
+)
+
+func readParty(t *testing.T, game sav.ISave) []rom_reader.Pokemon {
+	party, err := rom_reader.GetPartyPokemon(game)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	return party
+}
+
+func TestUpdatePartyMoves(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	wr := req.NewWriteRequest(0)
+	wr.WriteMoves("Swords Dance", "Night Slash", "Ice Shard")
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	moves := readParty(t, revalidate(t, updated))[0].Moves
+	expected := [4]rom_reader.Move{
+		{Id: 14, Name: "Swords Dance", PP: 30},
+		{Id: 400, Name: "Night Slash", PP: 15},
+		{Id: 420, Name: "Ice Shard", PP: 30},
+		{},
+	}
+
+	if moves != expected {
+		t.Fatalf("expected %+v, but got %+v\n", expected, moves)
+	}
+}
+
+func TestUpdatePartySyncsBattleStats(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	// TENTACRUEL, lv52 Brave
+	wr := req.NewWriteRequest(2)
+	wr.WriteLevel(100)
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	pokemon := readParty(t, revalidate(t, updated))[2]
+	expected := rom_reader.BattleStat{
+		Level: 100,
+		Stats: rom_reader.Stats{Hp: 282, Attack: 161, Defense: 171, SpAttack: 169, SpDefense: 247, Speed: 200},
+	}
+
+	if pokemon.BattleStat != expected {
+		t.Fatalf("expected %+v, but got %+v\n", expected, pokemon.BattleStat)
+	}
+
+	// slow growth rate
+	if pokemon.Experience != 1250000 {
+		t.Fatalf("expected %+v, but got %+v\n", 1250000, pokemon.Experience)
+	}
+}
+
+func TestUpdatePartyExperience(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	// TENTACRUEL, lv52 Brave. Slow growth rate, so lv60 starts at 270000 EXP
+	wr := req.NewWriteRequest(2)
+	wr.WriteExperience(270001)
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	pokemon := readParty(t, revalidate(t, updated))[2]
+	expected := rom_reader.BattleStat{
+		Level: 60,
+		Stats: rom_reader.Stats{Hp: 173, Attack: 99, Defense: 104, SpAttack: 103, SpDefense: 150, Speed: 121},
+	}
+
+	if pokemon.BattleStat != expected {
+		t.Fatalf("expected %+v, but got %+v\n", expected, pokemon.BattleStat)
+	}
+
+	if pokemon.Experience != 270001 {
+		t.Fatalf("expected %+v, but got %+v\n", 270001, pokemon.Experience)
+	}
+}
+
+func TestUpdatePartyExperienceValidation(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	wr := req.NewWriteRequest(2)
+	wr.WriteLevel(60)
+	wr.WriteExperience(270000)
+	if _, err := UpdatePartyPokemon(game, []req.WriteRequest{wr}); err == nil {
+		t.Fatal("expected LEVEL and EXPERIENCE writes to the same pokemon to be rejected")
+	}
+
+	game = readPlatinumMock(t)
+	wr = req.NewWriteRequest(2)
+	wr.WriteExperience(1250001)
+	if _, err := UpdatePartyPokemon(game, []req.WriteRequest{wr}); err == nil {
+		t.Fatal("expected EXP past the level 100 cap to be rejected")
+	}
+}
+
+// BW savefile whose only party pokemon is the Platinum mock's TENTACRUEL, turned into the given species
+func mockBWSave(t *testing.T, dexId uint16) sav.ISave {
+	plaintext, err := crypt.DecryptPokemon(readPlatinumMock(t).PartySection()[2*consts.PARTY_POKEMON_SIZE:])
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	blockA, _ := shuffler.GetPokemonBlockLocation(shuffler.A, binary.LittleEndian.Uint32(plaintext[0:4]))
+	binary.LittleEndian.PutUint16(plaintext[blockA:], dexId)
+
+	ciphertext, err := crypt.Encrypt(plaintext, consts.PARTY_POKEMON_SIZE_GEN5)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game := sav.NewSavBW(make([]byte, consts.SAVEFILE_SIZE))
+	copy(game.PartySection(), ciphertext)
+	binary.LittleEndian.PutUint32(game.Data()[game.PartyOffset()-4:], 1)
+	game.UpdateChecksums()
+
+	validated, err := sav.Validate(game.Data())
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	return validated
+}
+
+func TestUpdatePartyLevelGen5Species(t *testing.T) {
+	// HYDREIGON isn't in the gen 4 species table, so its level and EXP can't be synced
+	game := mockBWSave(t, 635)
+	before := readParty(t, game)[0]
+
+	wr := req.NewWriteRequest(0)
+	wr.WriteLevel(60)
+	wr.KeepBattleStats = true
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err = sav.Validate(updated)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, game)[0]
+	if after.PokedexId != 635 || after.Level != 60 {
+		t.Fatalf("expected a lv60 #635, but got a lv%d #%d\n", after.Level, after.PokedexId)
+	}
+
+	if after.Experience != before.Experience {
+		t.Fatalf("expected EXP to stay at %d, but got %d\n", before.Experience, after.Experience)
+	}
+}
+
+func TestUpdatePartyKeepsBattleStats(t *testing.T) {
+	game := readPlatinumMock(t)
+	before := readParty(t, game)[2].BattleStat.Stats
+
+	wr := req.NewWriteRequest(2)
+	wr.WriteLevel(100)
+	wr.KeepBattleStats = true
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, revalidate(t, updated))[2].BattleStat.Stats
+	if after != before {
+		t.Fatalf("expected %+v, but got %+v\n", before, after)
+	}
+}
+
+func TestUpdatePartyMakeShiny(t *testing.T) {
+	game := readPlatinumMock(t)
+	before := readParty(t, game)[2]
+
+	// the nickname is written in whichever block order the request is applied in
+	wr := req.NewWriteRequest(2)
+	wr.MakeShiny()
+	wr.WriteNickname("SHINY")
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, revalidate(t, updated))[2]
+	if !after.IsShiny {
+		t.Fatalf("expected 0x%08X to be shiny\n", after.Personality)
+	}
+
+	if after.Personality == before.Personality {
+		t.Fatal("expected the personality value to change")
+	}
+
+	before.Name = "SHINY"
+	before.Personality = after.Personality
+	before.IsShiny = true
+	if diff := cmp.Diff(before, after); diff != "" {
+		t.Fatalf("expected everything but the personality value to be kept (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdatePartyNature(t *testing.T) {
+	game := readPlatinumMock(t)
+	before := readParty(t, game)[2]
+
+	// TENTACRUEL, lv52 Brave
+	wr := req.NewWriteRequest(2)
+	wr.WriteNature("Modest", true)
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, revalidate(t, updated))[2]
+	if after.Nature != "Modest" {
+		t.Fatalf("expected %+v, but got %+v\n", "Modest", after.Nature)
+	}
+
+	if after.Gender != before.Gender || after.Ability != before.Ability || after.IsShiny != before.IsShiny {
+		t.Fatalf("expected gender, ability and shininess to be kept, but got %+v\n", after)
+	}
+
+	expected, err := stats.Calculate(after.PokedexId, after.IVs, after.EVs, 52, "Modest")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if after.BattleStat.Stats != expected {
+		t.Fatalf("expected %+v, but got %+v\n", expected, after.BattleStat.Stats)
+	}
+}
+
+func TestUpdatePartyShinyNature(t *testing.T) {
+	game := readPlatinumMock(t)
+
+	wr := req.NewWriteRequest(2)
+	wr.MakeShiny()
+	wr.WriteNature("Modest", false)
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, revalidate(t, updated))[2]
+	if !after.IsShiny || after.Nature != "Modest" {
+		t.Fatalf("expected a shiny Modest pokemon, but got %+v\n", after)
+	}
+}
+
+func TestUpdatePartyAbility(t *testing.T) {
+	game := readPlatinumMock(t)
+	before := readParty(t, game)[2]
+
+	// TENTACRUEL, Female Brave with Liquid Ooze, its second ability
+	invalid := []struct {
+		ability string
+		mode    req.PIDMode
+	}{
+		{"Clear Body", req.CHECK_PID},
+		{"Levitate", req.FIX_PID},
+	}
+
+	for _, c := range invalid {
+		wr := req.NewWriteRequest(2)
+		wr.WriteAbilityWithMode(c.ability, c.mode)
+
+		if _, err := UpdatePartyPokemon(readPlatinumMock(t), []req.WriteRequest{wr}); err == nil {
+			t.Fatalf("expected ability '%s' to be rejected\n", c.ability)
+		}
+	}
+
+	// the other ability is rejected without FIX_PID
+	wr := req.NewWriteRequest(2)
+	wr.WriteAbility("Clear Body")
+	if _, err := UpdatePartyPokemon(readPlatinumMock(t), []req.WriteRequest{wr}); err == nil {
+		t.Fatal("expected ability 'Clear Body' to be rejected")
+	}
+
+	wr = req.NewWriteRequest(2)
+	wr.WriteAbilityWithMode("Clear Body", req.FIX_PID)
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, revalidate(t, updated))[2]
+	if after.Ability != "Clear Body" || after.Personality&1 != 0 {
+		t.Fatalf("expected Clear Body in the first slot, but got %s with 0x%08X\n", after.Ability, after.Personality)
+	}
+
+	if after.Nature != before.Nature || after.Gender != before.Gender || after.IsShiny != before.IsShiny {
+		t.Fatalf("expected nature, gender and shininess to be kept, but got %+v\n", after)
+	}
+}
+
+func TestUpdatePartyGender(t *testing.T) {
+	game := readPlatinumMock(t)
+	before := readParty(t, game)[2]
+
+	// TENTACRUEL, Female Brave with Liquid Ooze
+	invalid := []struct {
+		gender string
+		mode   req.PIDMode
+	}{
+		{"Male", req.CHECK_PID},
+		{"Genderless", req.FIX_PID},
+		{"Unknown", req.FIX_PID},
+	}
+
+	for _, c := range invalid {
+		wr := req.NewWriteRequest(2)
+		wr.WriteGender(c.gender, c.mode)
+
+		if _, err := UpdatePartyPokemon(readPlatinumMock(t), []req.WriteRequest{wr}); err == nil {
+			t.Fatalf("expected gender '%s' to be rejected\n", c.gender)
+		}
+	}
+
+	wr := req.NewWriteRequest(2)
+	wr.WriteGender("Male", req.FIX_PID)
+
+	updated, err := UpdatePartyPokemon(game, []req.WriteRequest{wr})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	after := readParty(t, revalidate(t, updated))[2]
+	if after.Gender != "Male" || after.Personality&0xFF < 127 {
+		t.Fatalf("expected a male personality value, but got %s with 0x%08X\n", after.Gender, after.Personality)
+	}
+
+	if after.Nature != before.Nature || after.Ability != before.Ability || after.IsShiny != before.IsShiny {
+		t.Fatalf("expected nature, ability and shininess to be kept, but got %+v\n", after)
+	}
+
+	if after.FatefulEncounter != before.FatefulEncounter || after.Form != before.Form {
+		t.Fatalf("expected the rest of the flags byte to be kept, but got %+v\n", after)
+	}
+}
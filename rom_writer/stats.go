@@ -0,0 +1,150 @@
+package rom_writer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+	"github.com/dingdongg/pkmn-rom-parser/v7/shuffler"
+	"github.com/dingdongg/pkmn-rom-parser/v7/stats"
+)
+
+// requests that change an input of the battle stat formula
+var statInputs = map[string]bool{
+	req.EV:         true,
+	req.IV:         true,
+	req.LEVEL:      true,
+	req.EXPERIENCE: true,
+	req.NATURE:     true,
+}
+
+// derived fields to bring back in line, accumulated over all the requests for a party pokemon
+type pendingSync struct {
+	level     bool
+	exp       bool
+	stats     bool
+	keepStats bool
+}
+
+func (ps pendingSync) add(wr req.WriteRequest) pendingSync {
+	for request := range wr.Contents {
+		ps.stats = ps.stats || statInputs[request]
+	}
+
+	_, level := wr.Contents[req.LEVEL]
+	_, exp := wr.Contents[req.EXPERIENCE]
+	_, stats := wr.Contents[req.BATTLE_STATS]
+
+	ps.level = ps.level || level
+	ps.exp = ps.exp || exp
+	// battle stats written by the caller are kept as-is
+	ps.keepStats = ps.keepStats || stats || wr.KeepBattleStats
+	return ps
+}
+
+// level/EXP go first, since the battle stats depend on the level
+func (ps pendingSync) apply(savefile sav.ISave, plaintext []byte) error {
+	if ps.level || ps.exp {
+		if err := syncExperience(plaintext, ps.level, ps.exp); err != nil {
+			return err
+		}
+	}
+
+	if ps.stats && !ps.keepStats {
+		return syncBattleStats(savefile, plaintext)
+	}
+
+	return nil
+}
+
+// fills in the battle stats of a decrypted pokemon coming from the box format, which doesn't
+// store them: the level is derived from EXP, and the stats are computed at full HP
+func initBattleStats(savefile sav.ISave, plaintext []byte) error {
+	if err := syncExperience(plaintext, false, true); err != nil {
+		return err
+	}
+
+	if err := syncBattleStats(savefile, plaintext); err != nil {
+		return err
+	}
+
+	battleStats := plaintext[consts.BATTLE_STATS_OFFSET:]
+	copy(battleStats[consts.BATTLE_STATS_CURRENT_HP:], battleStats[consts.BATTLE_STATS_STAT:consts.BATTLE_STATS_STAT+2])
+	return nil
+}
+
+// recomputes the battle stats of a decrypted party pokemon from its species, IVs, EVs,
+// level and nature. Current HP moves along with max HP, so damage taken is preserved
+func syncBattleStats(savefile sav.ISave, plaintext []byte) error {
+	personality := binary.LittleEndian.Uint32(plaintext[0:4])
+
+	blockA, err := shuffler.GetPokemonBlock(plaintext, shuffler.A, personality)
+	if err != nil {
+		return err
+	}
+
+	blockB, err := shuffler.GetPokemonBlock(plaintext, shuffler.B, personality)
+	if err != nil {
+		return err
+	}
+
+	natureIndex := uint(personality % 25)
+	if savefile.Version().IsGen5() {
+		natureIndex = uint(blockB[consts.BLOCK_B_NATURE_GEN5])
+	}
+
+	nature, err := data.GetNature(natureIndex)
+	if err != nil {
+		return err
+	}
+
+	ev := blockA[consts.BLOCK_A_EV:]
+	evs := rom_reader.Stats{
+		Hp:        uint(ev[0]),
+		Attack:    uint(ev[1]),
+		Defense:   uint(ev[2]),
+		Speed:     uint(ev[3]),
+		SpAttack:  uint(ev[4]),
+		SpDefense: uint(ev[5]),
+	}
+
+	iv := binary.LittleEndian.Uint32(blockB[consts.BLOCK_B_IV:])
+	ivs := rom_reader.Stats{
+		Hp:        uint((iv >> 0) & 0b11111),
+		Attack:    uint((iv >> 5) & 0b11111),
+		Defense:   uint((iv >> 10) & 0b11111),
+		Speed:     uint((iv >> 15) & 0b11111),
+		SpAttack:  uint((iv >> 20) & 0b11111),
+		SpDefense: uint((iv >> 25) & 0b11111),
+	}
+
+	battleStats := plaintext[consts.BATTLE_STATS_OFFSET:]
+	dexId := binary.LittleEndian.Uint16(blockA[:2])
+	level := uint(battleStats[consts.BATTLE_STATS_LEVEL])
+
+	result, err := stats.Calculate(dexId, ivs, evs, level, nature)
+	if err != nil {
+		return fmt.Errorf("can't recompute battle stats (set KeepBattleStats to skip): %s", err)
+	}
+
+	bytes, err := req.WriteStats(result).Bytes()
+	if err != nil {
+		return err
+	}
+
+	oldMaxHp := int(binary.LittleEndian.Uint16(battleStats[consts.BATTLE_STATS_STAT:]))
+	currentHp := int(binary.LittleEndian.Uint16(battleStats[consts.BATTLE_STATS_CURRENT_HP:]))
+
+	// fainted pokemon stay fainted
+	if currentHp > 0 {
+		currentHp = min(max(currentHp+int(result.Hp)-oldMaxHp, 1), int(result.Hp))
+	}
+
+	binary.LittleEndian.PutUint16(battleStats[consts.BATTLE_STATS_CURRENT_HP:], uint16(currentHp))
+	copy(battleStats[consts.BATTLE_STATS_STAT:], bytes)
+	return nil
+}
@@ -0,0 +1,57 @@
+package rom_writer
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_writer/req"
+	"github.com/dingdongg/pkmn-rom-parser/v7/sav"
+)
+
+func UpdateTrainer(savefile sav.ISave, newData req.TrainerWriteRequest) ([]byte, error) {
+	gen4, ok := savefile.(sav.IGen4Save)
+	if !ok {
+		return []byte{}, fmt.Errorf("trainer data is only supported in gen 4 savefiles")
+	}
+
+	section := gen4.TrainerSection()
+
+	// validate everything first, so that a bad request doesn't leave the savefile half-written
+	changes := make(map[int][]byte)
+	masks := make(map[int][]byte)
+
+	for request, data := range newData.Contents {
+		if request == req.KANTO_BADGES && savefile.Version() != gamever.HGSS {
+			return []byte{}, fmt.Errorf("kanto badges only exist in HGSS")
+		}
+
+		bytes, err := data.Bytes()
+		if err != nil {
+			return []byte{}, err
+		}
+
+		dataOffset, err := req.GetTrainerWriteLocation(request)
+		if err != nil {
+			return []byte{}, err
+		}
+
+		changes[dataOffset] = bytes
+		if maskable, ok := data.(req.Maskable); ok {
+			masks[dataOffset] = maskable.Mask()
+		}
+	}
+
+	for dataOffset, bytes := range changes {
+		mask, masked := masks[dataOffset]
+
+		for i, b := range bytes {
+			if masked {
+				b = section[dataOffset+i]&^mask[i] | b&mask[i]
+			}
+			section[dataOffset+i] = b
+		}
+	}
+
+	savefile.UpdateChecksums()
+	return savefile.Data(), nil
+}
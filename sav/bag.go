@@ -0,0 +1,36 @@
+package sav
+
+import "github.com/dingdongg/pkmn-rom-parser/v7/consts/pocket"
+
+// Offset is relative to the start of the bag section. Every slot holds
+// a u16 item ID followed by a u16 quantity
+type BagPocket struct {
+	Pocket pocket.Pocket
+	Offset uint
+	Slots  uint
+}
+
+const BAG_SLOT_SIZE = 4
+
+// pockets are stored back to back, in a different order than the one shown in-game
+var bagPocketOrder = []pocket.Pocket{
+	pocket.ITEMS, pocket.KEY_ITEMS, pocket.TMS_HMS, pocket.MAIL,
+	pocket.MEDICINE, pocket.BERRIES, pocket.POKE_BALLS, pocket.BATTLE_ITEMS,
+}
+
+var dpPtBag = bagLayout(bagPocketOrder, []uint{165, 50, 100, 12, 40, 64, 15, 30})
+
+// HGSS has room for the extra HM and the apricorn balls, at the expense of battle items
+var hgssBag = bagLayout(bagPocketOrder, []uint{165, 50, 101, 12, 40, 64, 24, 13})
+
+func bagLayout(pockets []pocket.Pocket, slots []uint) []BagPocket {
+	var layout []BagPocket
+	offset := uint(0)
+
+	for i, p := range pockets {
+		layout = append(layout, BagPocket{p, offset, slots[i]})
+		offset += slots[i] * BAG_SLOT_SIZE
+	}
+
+	return layout
+}
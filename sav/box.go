@@ -0,0 +1,10 @@
+package sav
+
+import "encoding/binary"
+
+// Empty box/party slots are zeroed out, so they have neither a personality value nor a checksum
+func IsEmptySlot(pokemon []byte) bool {
+	personality := binary.LittleEndian.Uint32(pokemon[0:4])
+	checksum := binary.LittleEndian.Uint16(pokemon[6:8])
+	return personality == 0 && checksum == 0
+}
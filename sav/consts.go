@@ -1,9 +1,19 @@
 package sav
 
 import (
+	"encoding/binary"
 	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
 )
 
+const SECOND_CHUNK_OFFSET uint = uint(0x40000)
+
+const DP_SB_END uint = uint(0xC100) // non-inclusive
+const DP_BB_START uint = DP_SB_END + 0x0
+const DP_BB_END uint = DP_BB_START + 0x121E0 // non-inclusive
+
 const PLAT_SB_END uint = uint(0xCF2C) // non-inclusive
 const PLAT_BB_START uint = PLAT_SB_END + 0x0
 const PLAT_BB_END uint = PLAT_BB_START + 0x121E4 // non-inclusive
@@ -12,31 +22,56 @@ const HGSS_SB_END uint = uint(0xF628)            // non-inclusive
 const HGSS_BB_START uint = HGSS_SB_END + 0xD8    // padding included in hgss
 const HGSS_BB_END uint = HGSS_BB_START + 0x12310 // non-inclusive
 
+const BW_CHECKSUM_TABLE uint = uint(0x23F00)
+const BW_CHECKSUM_TABLE_SIZE uint = 0x8C
+const B2W2_CHECKSUM_TABLE uint = uint(0x25F00)
+const B2W2_CHECKSUM_TABLE_SIZE uint = 0x94
+
+// shared by BW and B2W2
+const GEN5_PARTY_BLOCK uint = uint(0x18E00)
+const GEN5_PARTY_BLOCK_SIZE uint = 0x534
+const GEN5_PARTY_BLOCK_INDEX uint = 26
+
 const MAGIC_TIMESTAMP_JP_INTL = 0x20060623
 const MAGIC_TIMESTAMP_KR = 0x20070903
 
 func identifyGameVersion(savefile []byte) (ISave, error) {
+	// savefiles packaged by emulators/flashcarts need to be unwrapped by the container package first
+	if len(savefile) != consts.SAVEFILE_SIZE {
+		return nil, fmt.Errorf("unexpected savefile size: expected %d bytes, got %d", consts.SAVEFILE_SIZE, len(savefile))
+	}
+
 	// gen 4 games start writing to the 0x40000-offset address space,
-	// check there for the existence of a valid footer
-	chunkTwoOffset := uint(0x40000)
+	// check there for the existence of a valid footer. The first chunk
+	// is checked too, in case the second chunk's footers are damaged
 	footerSize := uint(0x14)
 
-	if isPLAT(savefile, chunkTwoOffset, footerSize, PLAT_SB_END, PLAT_BB_END) {
-		fmt.Println("Game: pokemon PLAT")
-		return NewSavPLAT(savefile), nil
-	} else if isHGSS(savefile, chunkTwoOffset, footerSize, HGSS_SB_END, HGSS_BB_END) {
-		fmt.Println("Game: pokemon HGSS")
-		return NewSavHGSS(savefile), nil
+	for _, chunkOffset := range []uint{SECOND_CHUNK_OFFSET, 0x0} {
+		if isGen4(savefile, chunkOffset, footerSize, DP_SB_END, DP_BB_START, DP_BB_END) {
+			return NewSavDP(savefile), nil
+		} else if isGen4(savefile, chunkOffset, footerSize, PLAT_SB_END, PLAT_BB_START, PLAT_BB_END) {
+			return NewSavPLAT(savefile), nil
+		} else if isGen4(savefile, chunkOffset, footerSize, HGSS_SB_END, HGSS_BB_START, HGSS_BB_END) {
+			return NewSavHGSS(savefile), nil
+		}
+	}
+
+	if isGen5(savefile, BW_CHECKSUM_TABLE, BW_CHECKSUM_TABLE_SIZE) {
+		return NewSavBW(savefile), nil
+	} else if isGen5(savefile, B2W2_CHECKSUM_TABLE, B2W2_CHECKSUM_TABLE_SIZE) {
+		return NewSavB2W2(savefile), nil
 	}
 
 	return nil, fmt.Errorf("unrecognized game file")
 }
 
-func isPLAT(savefile []byte, offset uint, footerSize uint, smallBlockEnd uint, bigBlockEnd uint) bool {
+// both footers of a gen 4 chunk record the size of their block, which tells the games apart
+func isGen4(savefile []byte, offset uint, footerSize uint, smallBlockEnd uint, bigBlockStart uint, bigBlockEnd uint) bool {
 	smallFooter := getFooter(savefile[offset+smallBlockEnd-footerSize : offset+smallBlockEnd])
 	bigFooter := getFooter(savefile[offset+bigBlockEnd-footerSize : offset+bigBlockEnd])
 
-	if smallFooter.BlockSize != uint32(0xCF2C) {
+	// the small block starts at the beginning of the chunk
+	if smallFooter.BlockSize != uint32(smallBlockEnd) {
 		return false
 	}
 
@@ -44,7 +79,7 @@ func isPLAT(savefile []byte, offset uint, footerSize uint, smallBlockEnd uint, b
 		return false
 	}
 
-	if bigFooter.BlockSize != uint32(0x121E4) {
+	if bigFooter.BlockSize != uint32(bigBlockEnd-bigBlockStart) {
 		return false
 	}
 
@@ -55,25 +90,11 @@ func isPLAT(savefile []byte, offset uint, footerSize uint, smallBlockEnd uint, b
 	return true
 }
 
-func isHGSS(savefile []byte, offset uint, footerSize uint, smallBlockEnd uint, bigBlockEnd uint) bool {
-	smallFooter := getFooter(savefile[offset+smallBlockEnd-footerSize : offset+smallBlockEnd])
-	bigFooter := getFooter(savefile[offset+bigBlockEnd-footerSize : offset+bigBlockEnd])
-
-	if smallFooter.BlockSize != uint32(0xF628) {
-		return false
-	}
-
-	if smallFooter.K != MAGIC_TIMESTAMP_JP_INTL && smallFooter.K != MAGIC_TIMESTAMP_KR {
-		return false
-	}
+// gen 5 games have no footer magic to look for, but the checksum table
+// sits at a fixed offset and carries its own checksum
+func isGen5(savefile []byte, tableOffset uint, tableSize uint) bool {
+	table := gen5Block{tableOffset, tableSize, 0}
+	stored := binary.LittleEndian.Uint16(savefile[table.tableChecksumAddress():])
 
-	if bigFooter.BlockSize != uint32(0x12310) {
-		return false
-	}
-
-	if bigFooter.K != MAGIC_TIMESTAMP_JP_INTL && bigFooter.K != MAGIC_TIMESTAMP_KR {
-		return false
-	}
-
-	return true
+	return crypt.CRC16_CCITT(savefile[tableOffset:tableOffset+tableSize]) == stored
 }
@@ -0,0 +1,82 @@
+package sav
+
+import (
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+)
+
+type Region int
+
+const (
+	REGION_UNKNOWN Region = iota
+	REGION_JP_INTL        // japanese and international releases
+	REGION_KR             // korean releases
+)
+
+type BlockInfo struct {
+	SaveNumber    uint32
+	ChecksumValid bool
+}
+
+type ChunkInfo struct {
+	SmallBlock BlockInfo
+	BigBlock   BlockInfo
+}
+
+/*
+Metadata read from the block footers of a savefile.
+
+Chunk indexes follow ChunkSelection: 0 is the chunk at 0x0, 1 is the chunk at 0x40000.
+The active big block can live in a different chunk than the active small block.
+Gen 5 savefiles have no footers, so only their version is filled in.
+*/
+type SaveInfo struct {
+	Version        gamever.GameVer
+	Region         Region
+	Chunks         [2]ChunkInfo
+	ActiveChunk    uint
+	ActiveBigBlock uint
+}
+
+// Unlike Validate, a savefile with corrupted blocks is still described instead of
+// rejected, so that the checksum status of every block can be inspected
+func GetInfo(savefile []byte) (SaveInfo, error) {
+	game, err := identifyGameVersion(savefile)
+	if err != nil {
+		return SaveInfo{}, err
+	}
+
+	info := SaveInfo{Version: game.Version()}
+
+	gen4, ok := game.(IGen4Save)
+	if !ok {
+		return info, nil
+	}
+
+	chunks := [2]Chunk{gen4.Chunk(0x0), gen4.Chunk(SECOND_CHUNK_OFFSET)}
+	// the selection is made even when validation fails, so it's not read from the savefile
+	selection, _ := selectChunk(chunks)
+
+	for i, c := range chunks {
+		info.Chunks[i] = ChunkInfo{
+			SmallBlock: BlockInfo{c.SmallBlock.Footer.SaveNumber, selection.SmallBlockValid[i]},
+			BigBlock:   BlockInfo{c.BigBlock.Footer.SaveNumber, selection.BigBlockValid[i]},
+		}
+	}
+
+	info.ActiveChunk = selection.SmallBlockUsed
+	info.ActiveBigBlock = selection.BigBlockUsed
+	info.Region = regionOf(chunks[selection.SmallBlockUsed].SmallBlock.Footer.K)
+
+	return info, nil
+}
+
+func regionOf(k uint32) Region {
+	switch k {
+	case MAGIC_TIMESTAMP_JP_INTL:
+		return REGION_JP_INTL
+	case MAGIC_TIMESTAMP_KR:
+		return REGION_KR
+	}
+
+	return REGION_UNKNOWN
+}
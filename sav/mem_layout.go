@@ -65,13 +65,16 @@ func (f Footer) String() string {
 }
 
 func (c Chunk) IsValid() bool {
-	smallChecksum := crypt.CRC16_CCITT(c.SmallBlock.BlockData)
-	// fmt.Printf("smallblock: expected 0x%x, got 0x%x\n", c.SmallBlock.Footer.Checksum, smallChecksum)
-	if smallChecksum != c.SmallBlock.Footer.Checksum {
-		return false
-	}
+	return c.SmallBlock.IsValid() && c.BigBlock.IsValid()
+}
 
-	bigChecksum := crypt.CRC16_CCITT(c.BigBlock.BlockData)
-	// fmt.Printf("bigblock: expected 0x%x, got 0x%x\n", c.BigBlock.Footer.Checksum, bigChecksum)
-	return bigChecksum == c.BigBlock.Footer.Checksum
-}
\ No newline at end of file
+func (b Block) IsValid() bool {
+	return crypt.CRC16_CCITT(b.BlockData) == b.Footer.Checksum
+}
+
+// recomputes the block's checksum and stores it in the footer, inside the given savefile
+func (b Block) writeChecksum(savefile []byte) {
+	checksum := crypt.CRC16_CCITT(b.BlockData)
+	end := b.Address + uint(b.Footer.BlockSize)
+	binary.LittleEndian.PutUint16(savefile[end-0x2:end], checksum)
+}
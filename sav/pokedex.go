@@ -0,0 +1,146 @@
+package sav
+
+// relative to the start of the pokedex section. One bit per species, starting with #1
+const (
+	DEX_MAGIC         = 0x0
+	DEX_CAUGHT        = 0x4
+	DEX_SEEN          = 0x44
+	DEX_FIRST_GENDER  = 0x84 // gender the species was first seen as
+	DEX_SECOND_GENDER = 0xC4 // the other gender, once seen. Same as the first gender otherwise
+)
+
+const DEX_SPECIES = 493
+
+// Forms are recorded in the order they were seen, Bits per slot.
+// A slot with every bit set is empty
+type FormField struct {
+	Offset uint
+	Bits   uint
+	Slots  uint
+}
+
+type PokedexLayout struct {
+	NationalDexFlag uint                 // 0 if the game's flag location isn't known
+	Forms           map[uint16]FormField // keyed by national dex ID
+}
+
+// the seen/caught/gender flags and the first form flags are laid out like in Platinum,
+// but DP has no Rotom, Giratina or Shaymin forms to record
+var dpDex = &PokedexLayout{0, sinnohForms()}
+
+var platDex = pokedexLayout(0x319, 0x31C)
+
+// HGSS stores 0x1C more bytes of data before the national dex flag
+var hgssDex = pokedexLayout(0x335, 0x338)
+
+func pokedexLayout(nationalDexFlag uint, extraForms uint) *PokedexLayout {
+	forms := sinnohForms()
+	forms[479] = FormField{extraForms, 3, 6}       // Rotom
+	forms[487] = FormField{extraForms + 0x5, 1, 2} // Giratina
+	forms[492] = FormField{extraForms + 0x4, 1, 2} // Shaymin
+
+	return &PokedexLayout{nationalDexFlag, forms}
+}
+
+// forms recorded right after the gender flags, in every gen 4 game
+func sinnohForms() map[uint16]FormField {
+	return map[uint16]FormField{
+		201: {0x10C, 8, 28}, // Unown
+		412: {0x10A, 2, 3},  // Burmy
+		413: {0x10B, 2, 3},  // Wormadam
+		422: {0x108, 1, 2},  // Shellos
+		423: {0x109, 1, 2},  // Gastrodon
+	}
+}
+
+// Forms in the order they were seen. With a single bit per slot, a species
+// only seen in its second form can't be told apart from one with no forms seen
+func (f FormField) Read(section []byte) []uint {
+	forms := make([]uint, 0)
+	empty := uint(1)<<f.Bits - 1
+
+	for i := uint(0); i < f.Slots; i++ {
+		form := getBits(section, f.Offset, i*f.Bits, f.Bits)
+		if f.Bits > 1 && form == empty {
+			break
+		}
+
+		forms = append(forms, form)
+	}
+
+	if f.Bits == 1 {
+		if forms[0] == 1 && forms[1] == 1 {
+			return []uint{}
+		}
+
+		if forms[0] == forms[1] {
+			return forms[:1]
+		}
+	}
+
+	return forms
+}
+
+// Bits outside of the field's slots are left untouched. With a single bit per slot,
+// the second form alone can't be written, since it would read back as no forms seen
+func (f FormField) Write(section []byte, forms []uint) {
+	empty := uint(1)<<f.Bits - 1
+
+	for i := uint(0); i < f.Slots; i++ {
+		form := empty
+		if i < uint(len(forms)) {
+			form = forms[i]
+		} else if f.Bits == 1 && len(forms) == 1 {
+			// the second slot repeats the first one when a single form was seen
+			form = forms[0]
+		}
+
+		setBits(section, f.Offset, i*f.Bits, f.Bits, form)
+	}
+}
+
+func (f FormField) FormCount() uint {
+	if f.Bits == 1 {
+		return 2
+	}
+
+	return f.Slots
+}
+
+// Reads a species' flag from one of the one-bit-per-species arrays
+func GetDexFlag(section []byte, base uint, species uint16) bool {
+	return getBits(section, base, uint(species-1), 1) == 1
+}
+
+func SetDexFlag(section []byte, base uint, species uint16, flag bool) {
+	value := uint(0)
+	if flag {
+		value = 1
+	}
+
+	setBits(section, base, uint(species-1), 1, value)
+}
+
+// bits are read least significant first, starting from the byte at offset
+func getBits(buf []byte, offset uint, bitPos uint, n uint) uint {
+	value := uint(0)
+
+	for i := uint(0); i < n; i++ {
+		pos := bitPos + i
+		bit := uint(buf[offset+pos/8]>>(pos%8)) & 1
+		value |= bit << i
+	}
+
+	return value
+}
+
+func setBits(buf []byte, offset uint, bitPos uint, n uint, value uint) {
+	for i := uint(0); i < n; i++ {
+		pos := bitPos + i
+		mask := byte(1 << (pos % 8))
+		buf[offset+pos/8] &^= mask
+		if (value>>i)&1 == 1 {
+			buf[offset+pos/8] |= mask
+		}
+	}
+}
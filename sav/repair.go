@@ -0,0 +1,154 @@
+package sav
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+)
+
+const (
+	SMALL_BLOCK_TYPE uint16 = iota
+	BIG_BLOCK_TYPE
+)
+
+/*
+Rebuilds corrupted blocks so that the savefile passes validation again.
+
+A corrupted block is overwritten with its intact copy from the other chunk.
+If neither copy is intact, both get their footers rebuilt and their checksums
+recomputed over whatever data is left, so that the game loads the save at all.
+
+The given savefile is left untouched; the repaired copy is returned.
+*/
+func Repair(savefile []byte) ([]byte, error) {
+	repaired := make([]byte, len(savefile))
+	copy(repaired, savefile)
+
+	game, err := identifyGameVersion(repaired)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	layout := asGen4Savefile(game)
+	if layout == nil {
+		return []byte{}, fmt.Errorf("repairs are only supported for gen 4 savefiles")
+	}
+
+	gen4 := game.(IGen4Save)
+	chunks := [2]Chunk{gen4.Chunk(0x0), gen4.Chunk(SECOND_CHUNK_OFFSET)}
+	magic := regionMagic(chunks)
+
+	smallBlocks := [2]Block{chunks[0].SmallBlock, chunks[1].SmallBlock}
+	repairBlocks(repaired, smallBlocks, layout.smallBlockSize, SMALL_BLOCK_TYPE, magic)
+
+	bigBlocks := [2]Block{chunks[0].BigBlock, chunks[1].BigBlock}
+	repairBlocks(repaired, bigBlocks, layout.bigBlockSize, BIG_BLOCK_TYPE, magic)
+
+	if err := game.Validate(); err != nil {
+		return []byte{}, fmt.Errorf("failed to repair savefile: %s", err)
+	}
+
+	return repaired, nil
+}
+
+// repairs the two copies of a block (one from each chunk)
+func repairBlocks(savefile []byte, blocks [2]Block, blockSize uint, blockType uint16, magic uint32) {
+	// footers are checked first, since a block with a valid checksum can still have a damaged footer
+	for i, b := range blocks {
+		if footerIntact(b.Footer, blockSize) {
+			continue
+		}
+
+		rebuilt := Footer{b.Footer.Identifier, b.Footer.SaveNumber, uint32(blockSize), magic, blockType, b.Footer.Checksum}
+		other := blocks[1-i].Footer
+
+		// the other copy's footer is the best guess for the link to the other block type.
+		// This copy is marked as the older save, so that the intact one keeps priority
+		if footerIntact(other, blockSize) {
+			rebuilt.Identifier = other.Identifier
+			rebuilt.SaveNumber = other.SaveNumber
+			if rebuilt.SaveNumber > 0 {
+				rebuilt.SaveNumber--
+			}
+			rebuilt.T = other.T
+		}
+
+		writeFooter(savefile, b, blockSize, rebuilt)
+		blocks[i] = readBlock(savefile, b, blockSize)
+	}
+
+	valid := [2]bool{blocks[0].IsValid(), blocks[1].IsValid()}
+
+	if valid[0] && valid[1] {
+		return
+	}
+
+	if valid[0] != valid[1] {
+		intact, corrupted := blocks[0], blocks[1]
+		if valid[1] {
+			intact, corrupted = blocks[1], blocks[0]
+		}
+
+		copy(savefile[corrupted.Address:corrupted.Address+blockSize], savefile[intact.Address:intact.Address+blockSize])
+		return
+	}
+
+	for _, b := range blocks {
+		checksum := crypt.CRC16_CCITT(b.BlockData)
+		end := b.Address + blockSize
+		binary.LittleEndian.PutUint16(savefile[end-0x2:end], checksum)
+	}
+}
+
+func footerIntact(f Footer, blockSize uint) bool {
+	return f.BlockSize == uint32(blockSize) && (f.K == MAGIC_TIMESTAMP_JP_INTL || f.K == MAGIC_TIMESTAMP_KR)
+}
+
+// HGSS footers are 0x10 bytes long and don't include the identifier,
+// so only the bytes that actually belong to the footer are overwritten
+func writeFooter(savefile []byte, b Block, blockSize uint, f Footer) {
+	buf := make([]byte, 0x14)
+	binary.LittleEndian.PutUint32(buf[0x0:0x4], f.Identifier)
+	binary.LittleEndian.PutUint32(buf[0x4:0x8], f.SaveNumber)
+	binary.LittleEndian.PutUint32(buf[0x8:0xC], f.BlockSize)
+	binary.LittleEndian.PutUint32(buf[0xC:0x10], f.K)
+	binary.LittleEndian.PutUint16(buf[0x10:0x12], f.T)
+	binary.LittleEndian.PutUint16(buf[0x12:0x14], f.Checksum)
+
+	footerSize := blockSize - uint(len(b.BlockData))
+	end := b.Address + blockSize
+	copy(savefile[end-footerSize:end], buf[0x14-footerSize:])
+}
+
+func readBlock(savefile []byte, b Block, blockSize uint) Block {
+	dataEnd := b.Address + uint(len(b.BlockData))
+	end := b.Address + blockSize
+	return NewBlock(savefile[b.Address:dataEnd], savefile[end-0x14:end], b.Address)
+}
+
+// the region magic of the first intact-looking footer, defaulting to the international one
+func regionMagic(chunks [2]Chunk) uint32 {
+	for _, c := range chunks {
+		for _, f := range []Footer{c.SmallBlock.Footer, c.BigBlock.Footer} {
+			if f.K == MAGIC_TIMESTAMP_JP_INTL || f.K == MAGIC_TIMESTAMP_KR {
+				return f.K
+			}
+		}
+	}
+
+	return MAGIC_TIMESTAMP_JP_INTL
+}
+
+func asGen4Savefile(game ISave) *gen4Savefile {
+	switch g := game.(type) {
+	case *savDP:
+		return (*gen4Savefile)(g)
+	case *savPLAT:
+		return (*gen4Savefile)(g)
+	case *savHGSS:
+		return (*gen4Savefile)(g)
+	}
+
+	return nil
+}
@@ -5,28 +5,67 @@ import (
 )
 
 type ISave interface {
-	Chunk(offset uint) Chunk
 	Validate() error
-	LatestData() *Chunk
+	Version() gamever.GameVer
 	PartySection() []byte
 	PartySize() uint32
 	PartyOffset() uint
+	PartyPokemonSize() uint
+	UpdateChecksums()
 	Get(start uint, numBytes uint) []byte
 	Data() []byte
 }
 
+// savefiles laid out as two chunks (0x0 and 0x40000), each holding a small and a big block
+type IGen4Save interface {
+	ISave
+	Chunk(offset uint) Chunk
+	LatestData() *Chunk
+	Selection() ChunkSelection
+	SetPartySize(size uint32)
+	TrainerSection() []byte
+	BoxSection() []byte
+	BoxSize() uint
+	BoxMetadataSection() []byte
+	BagSection() []byte
+	BagPockets() []BagPocket
+	PokedexSection() []byte
+	PokedexLayout() *PokedexLayout
+}
+
 type gen4Savefile struct {
 	version        gamever.GameVer
 	data           []byte
 	smallBlockSize uint
 	bigBlockSize   uint
 	partyOffset    uint
+	trainerOffset  uint
+	bagOffset      uint
+	bagPockets     []BagPocket
+	pokedexOffset  uint
+	pokedexLayout  *PokedexLayout // nil if the game's layout isn't known
+	boxOffset      uint           // relative to the start of the big block
+	boxSize        uint
+	boxNamesOffset uint            // relative to the start of the big block, followed by the wallpapers
+	selection      *ChunkSelection // set once the savefile is validated
 }
 
 // tODO: include important offsets as fields
+type savDP gen4Savefile
 type savPLAT gen4Savefile
 type savHGSS gen4Savefile
 
+type gen5Savefile struct {
+	version       gamever.GameVer
+	data          []byte
+	checksumTable gen5Block
+	partyBlock    gen5Block
+	partyOffset   uint
+}
+
+type savBW gen5Savefile
+type savB2W2 gen5Savefile
+
 func Validate(savefile []byte) (ISave, error) {
 	game, err := identifyGameVersion(savefile)
 	if err != nil {
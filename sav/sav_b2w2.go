@@ -0,0 +1,53 @@
+package sav
+
+import (
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+)
+
+func NewSavB2W2(savefile []byte) *savB2W2 {
+	return &savB2W2{
+		version:       gamever.B2W2,
+		data:          savefile,
+		checksumTable: gen5Block{B2W2_CHECKSUM_TABLE, B2W2_CHECKSUM_TABLE_SIZE, 0},
+		partyBlock:    gen5Block{GEN5_PARTY_BLOCK, GEN5_PARTY_BLOCK_SIZE, GEN5_PARTY_BLOCK_INDEX},
+		partyOffset:   GEN5_PARTY_BLOCK + 0x8,
+	}
+}
+
+func (sav *savB2W2) Validate() error {
+	return (*gen5Savefile)(sav).validate()
+}
+
+func (sav *savB2W2) Version() gamever.GameVer {
+	return sav.version
+}
+
+func (sav *savB2W2) PartySection() []byte {
+	return sav.data[sav.partyOffset:]
+}
+
+func (sav *savB2W2) PartySize() uint32 {
+	return (*gen5Savefile)(sav).partySize()
+}
+
+// gen 5 has no chunks, so this is an absolute offset into the savefile
+func (sav *savB2W2) PartyOffset() uint {
+	return sav.partyOffset
+}
+
+func (sav *savB2W2) PartyPokemonSize() uint {
+	return consts.PARTY_POKEMON_SIZE_GEN5
+}
+
+func (sav *savB2W2) UpdateChecksums() {
+	(*gen5Savefile)(sav).updateChecksums()
+}
+
+func (sav *savB2W2) Get(start uint, numBytes uint) []byte {
+	return sav.data[start : start+numBytes]
+}
+
+func (sav *savB2W2) Data() []byte {
+	return sav.data
+}
@@ -0,0 +1,53 @@
+package sav
+
+import (
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+)
+
+func NewSavBW(savefile []byte) *savBW {
+	return &savBW{
+		version:       gamever.BW,
+		data:          savefile,
+		checksumTable: gen5Block{BW_CHECKSUM_TABLE, BW_CHECKSUM_TABLE_SIZE, 0},
+		partyBlock:    gen5Block{GEN5_PARTY_BLOCK, GEN5_PARTY_BLOCK_SIZE, GEN5_PARTY_BLOCK_INDEX},
+		partyOffset:   GEN5_PARTY_BLOCK + 0x8,
+	}
+}
+
+func (sav *savBW) Validate() error {
+	return (*gen5Savefile)(sav).validate()
+}
+
+func (sav *savBW) Version() gamever.GameVer {
+	return sav.version
+}
+
+func (sav *savBW) PartySection() []byte {
+	return sav.data[sav.partyOffset:]
+}
+
+func (sav *savBW) PartySize() uint32 {
+	return (*gen5Savefile)(sav).partySize()
+}
+
+// gen 5 has no chunks, so this is an absolute offset into the savefile
+func (sav *savBW) PartyOffset() uint {
+	return sav.partyOffset
+}
+
+func (sav *savBW) PartyPokemonSize() uint {
+	return consts.PARTY_POKEMON_SIZE_GEN5
+}
+
+func (sav *savBW) UpdateChecksums() {
+	(*gen5Savefile)(sav).updateChecksums()
+}
+
+func (sav *savBW) Get(start uint, numBytes uint) []byte {
+	return sav.data[start : start+numBytes]
+}
+
+func (sav *savBW) Data() []byte {
+	return sav.data
+}
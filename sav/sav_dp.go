@@ -0,0 +1,151 @@
+package sav
+
+import (
+	"encoding/binary"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+)
+
+func NewSavDP(savefile []byte) *savDP {
+	return &savDP{
+		version:        gamever.DP,
+		data:           savefile,
+		smallBlockSize: 0xC100,
+		bigBlockSize:   0x121E0,
+		partyOffset:    0x98,
+		trainerOffset:  0x64,
+		bagOffset:      0x624,
+		bagPockets:     dpPtBag,
+		pokedexOffset:  0x12DC,
+		pokedexLayout:  dpDex,
+		boxOffset:      0x4,
+		boxSize:        0xFF0,
+		boxNamesOffset: 0x11EE4,
+	}
+}
+
+func (sav *savDP) Chunk(offset uint) Chunk {
+	sbData := sav.data[0x0+offset : sav.smallBlockSize+offset-0x14]
+	sbFooter := sav.data[sav.smallBlockSize+offset-0x14 : sav.smallBlockSize+offset]
+	small := NewBlock(sbData, sbFooter, 0x0+offset)
+
+	bbData := sav.data[sav.smallBlockSize+offset : sav.smallBlockSize+offset+sav.bigBlockSize-0x14]
+	bbFooter := sav.data[sav.smallBlockSize+offset+sav.bigBlockSize-0x14 : sav.smallBlockSize+offset+sav.bigBlockSize]
+	big := NewBlock(bbData, bbFooter, sav.smallBlockSize+offset)
+
+	return Chunk{
+		SmallBlock: small,
+		BigBlock:   big,
+	}
+}
+
+func (sav *savDP) Validate() error {
+	sav.selection = nil
+
+	selection, err := selectChunk([2]Chunk{sav.Chunk(0x0), sav.Chunk(SECOND_CHUNK_OFFSET)})
+	if err != nil {
+		return err
+	}
+
+	sav.selection = &selection
+	return nil
+}
+
+// The selection is made once, when the savefile is validated. Edits to the latest
+// blocks would otherwise make them look corrupted until their checksums are updated.
+// Savefiles that weren't validated get the same pick on first access
+func (sav *savDP) Selection() ChunkSelection {
+	if sav.selection == nil {
+		selection, _ := selectChunk([2]Chunk{sav.Chunk(0x0), sav.Chunk(SECOND_CHUNK_OFFSET)})
+		sav.selection = &selection
+	}
+	return *sav.selection
+}
+
+func (sav *savDP) LatestData() *Chunk {
+	return latestChunk([2]Chunk{sav.Chunk(0x0), sav.Chunk(SECOND_CHUNK_OFFSET)}, sav.Selection())
+}
+
+func (sav *savDP) PartySection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.partyOffset:]
+}
+
+func (sav *savDP) PartySize() uint32 {
+	latest := sav.LatestData()
+	offset := latest.SmallBlock.Address + sav.partyOffset
+	return binary.LittleEndian.Uint32(sav.data[offset-4 : offset])
+}
+
+func (sav *savDP) SetPartySize(size uint32) {
+	latest := sav.LatestData()
+	offset := latest.SmallBlock.Address + sav.partyOffset
+	binary.LittleEndian.PutUint32(sav.data[offset-4:offset], size)
+}
+
+func (sav *savDP) TrainerSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.trainerOffset:]
+}
+
+func (sav *savDP) BagSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.bagOffset:]
+}
+
+func (sav *savDP) BagPockets() []BagPocket {
+	return sav.bagPockets
+}
+
+func (sav *savDP) PokedexSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.pokedexOffset:]
+}
+
+func (sav *savDP) PokedexLayout() *PokedexLayout {
+	return sav.pokedexLayout
+}
+
+// box data lives in the big block, starting with the first box
+func (sav *savDP) BoxSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.BigBlock.Address+sav.boxOffset:]
+}
+
+func (sav *savDP) BoxSize() uint {
+	return sav.boxSize
+}
+
+// box names, followed by the box wallpapers and the special wallpaper unlock flags
+func (sav *savDP) BoxMetadataSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.BigBlock.Address+sav.boxNamesOffset:]
+}
+
+func (sav *savDP) PartyOffset() uint {
+	return sav.partyOffset
+}
+
+func (sav *savDP) PartyPokemonSize() uint {
+	return consts.PARTY_POKEMON_SIZE
+}
+
+func (sav *savDP) Version() gamever.GameVer {
+	return sav.version
+}
+
+// party and trainer data live in the small block, box data in the big block
+func (sav *savDP) UpdateChecksums() {
+	latest := sav.LatestData()
+	latest.SmallBlock.writeChecksum(sav.data)
+	latest.BigBlock.writeChecksum(sav.data)
+}
+
+func (sav *savDP) Get(start uint, numBytes uint) []byte {
+	return sav.data[start : start+numBytes]
+}
+
+func (sav *savDP) Data() []byte {
+	return sav.data
+}
@@ -0,0 +1,77 @@
+package sav
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+)
+
+/*
+gen 5 savefiles don't use the gen 4 small/big block chunks. Instead, the
+savefile is split into many blocks, each followed by a footer holding its
+CRC16-CCITT checksum. A mirror of every block's checksum is kept in a checksum
+table near the end of the save, and the table is checksummed as well.
+
+Only the blocks this package reads from or writes to are modelled.
+*/
+type gen5Block struct {
+	Address uint
+	Size    uint // excludes the footer
+	Index   uint // position of the block's checksum within the checksum table
+}
+
+func (b gen5Block) checksumAddress() uint {
+	return b.Address + b.Size + 0x2
+}
+
+// the checksum table has a larger footer than regular blocks
+func (b gen5Block) tableChecksumAddress() uint {
+	return b.Address + b.Size + 0xE
+}
+
+func (sav *gen5Savefile) checksum(block gen5Block) uint16 {
+	return crypt.CRC16_CCITT(sav.data[block.Address : block.Address+block.Size])
+}
+
+func (sav *gen5Savefile) storedChecksum(address uint) uint16 {
+	return binary.LittleEndian.Uint16(sav.data[address : address+2])
+}
+
+func (sav *gen5Savefile) mirrorAddress(block gen5Block) uint {
+	return sav.checksumTable.Address + block.Index*2
+}
+
+func (sav *gen5Savefile) validate() error {
+	table := sav.checksumTable
+	if sav.checksum(table) != sav.storedChecksum(table.tableChecksumAddress()) {
+		return fmt.Errorf("invalid savefile: checksum table is corrupted")
+	}
+
+	party := sav.partyBlock
+	checksum := sav.checksum(party)
+	if checksum != sav.storedChecksum(party.checksumAddress()) {
+		return fmt.Errorf("invalid savefile: party block checksum mismatch")
+	}
+
+	if checksum != sav.storedChecksum(sav.mirrorAddress(party)) {
+		return fmt.Errorf("invalid savefile: party block checksum doesn't match checksum table")
+	}
+
+	return nil
+}
+
+func (sav *gen5Savefile) updateChecksums() {
+	party := sav.partyBlock
+	checksum := sav.checksum(party)
+	binary.LittleEndian.PutUint16(sav.data[party.checksumAddress():], checksum)
+	binary.LittleEndian.PutUint16(sav.data[sav.mirrorAddress(party):], checksum)
+
+	// the table changed, so its own checksum has to follow
+	table := sav.checksumTable
+	binary.LittleEndian.PutUint16(sav.data[table.tableChecksumAddress():], sav.checksum(table))
+}
+
+func (sav *gen5Savefile) partySize() uint32 {
+	return binary.LittleEndian.Uint32(sav.data[sav.partyOffset-4 : sav.partyOffset])
+}
@@ -2,8 +2,8 @@ package sav
 
 import (
 	"encoding/binary"
-	"fmt"
 
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts"
 	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
 )
 
@@ -14,6 +14,14 @@ func NewSavHGSS(savefile []byte) *savHGSS {
 		smallBlockSize: 0xF628,
 		bigBlockSize:   0x12310,
 		partyOffset:    0x98,
+		trainerOffset:  0x64,
+		bagOffset:      0x644,
+		bagPockets:     hgssBag,
+		pokedexOffset:  0x12B8,
+		pokedexLayout:  hgssDex,
+		boxOffset:      0x0,
+		boxSize:        0x1000,
+		boxNamesOffset: 0x12008,
 	}
 }
 
@@ -35,44 +43,30 @@ func (sav *savHGSS) Chunk(offset uint) Chunk {
 }
 
 func (sav *savHGSS) Validate() error {
-	firstChunk := sav.Chunk(0x0)
-	secondChunk := sav.Chunk(0x40000)
+	sav.selection = nil
 
-	if !firstChunk.IsValid() {
-		fmt.Println("First chunk invalid")
-		return fmt.Errorf("invalid savefile")
-	}
-
-	if !secondChunk.IsValid() {
-		fmt.Println("Second chunk invalid")
-		return fmt.Errorf("invalid savefile")
+	selection, err := selectChunk([2]Chunk{sav.Chunk(0x0), sav.Chunk(SECOND_CHUNK_OFFSET)})
+	if err != nil {
+		return err
 	}
 
+	sav.selection = &selection
 	return nil
 }
 
-func (sav *savHGSS) LatestData() *Chunk {
-	chunk1 := sav.Chunk(0x0)
-	chunk2 := sav.Chunk(0x40000)
-
-	var latestSmallBlock Block
-	if chunk1.SmallBlock.Footer.SaveNumber >= chunk2.SmallBlock.Footer.SaveNumber {
-		latestSmallBlock = chunk1.SmallBlock
-	} else {
-		latestSmallBlock = chunk2.SmallBlock
-	}
-
-	var latestBigBlock Block
-	if latestSmallBlock.Footer.Identifier == chunk1.BigBlock.Footer.Identifier {
-		latestBigBlock = chunk1.BigBlock
-	} else {
-		latestBigBlock = chunk2.BigBlock
+// The selection is made once, when the savefile is validated. Edits to the latest
+// blocks would otherwise make them look corrupted until their checksums are updated.
+// Savefiles that weren't validated get the same pick on first access
+func (sav *savHGSS) Selection() ChunkSelection {
+	if sav.selection == nil {
+		selection, _ := selectChunk([2]Chunk{sav.Chunk(0x0), sav.Chunk(SECOND_CHUNK_OFFSET)})
+		sav.selection = &selection
 	}
+	return *sav.selection
+}
 
-	return &Chunk{
-		SmallBlock: latestSmallBlock,
-		BigBlock:   latestBigBlock,
-	}
+func (sav *savHGSS) LatestData() *Chunk {
+	return latestChunk([2]Chunk{sav.Chunk(0x0), sav.Chunk(SECOND_CHUNK_OFFSET)}, sav.Selection())
 }
 
 func (sav *savHGSS) PartySection() []byte {
@@ -86,10 +80,70 @@ func (sav *savHGSS) PartySize() uint32 {
 	return binary.LittleEndian.Uint32(sav.data[offset-4 : offset])
 }
 
+func (sav *savHGSS) SetPartySize(size uint32) {
+	latest := sav.LatestData()
+	offset := latest.SmallBlock.Address + sav.partyOffset
+	binary.LittleEndian.PutUint32(sav.data[offset-4:offset], size)
+}
+
+func (sav *savHGSS) TrainerSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.trainerOffset:]
+}
+
+func (sav *savHGSS) BagSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.bagOffset:]
+}
+
+func (sav *savHGSS) BagPockets() []BagPocket {
+	return sav.bagPockets
+}
+
+func (sav *savHGSS) PokedexSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.SmallBlock.Address+sav.pokedexOffset:]
+}
+
+func (sav *savHGSS) PokedexLayout() *PokedexLayout {
+	return sav.pokedexLayout
+}
+
+// box data lives in the big block, starting with the first box
+func (sav *savHGSS) BoxSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.BigBlock.Address+sav.boxOffset:]
+}
+
+func (sav *savHGSS) BoxSize() uint {
+	return sav.boxSize
+}
+
+// box names, followed by the box wallpapers and the special wallpaper unlock flags
+func (sav *savHGSS) BoxMetadataSection() []byte {
+	latest := sav.LatestData()
+	return sav.data[latest.BigBlock.Address+sav.boxNamesOffset:]
+}
+
 func (sav *savHGSS) PartyOffset() uint {
 	return sav.partyOffset
 }
 
+func (sav *savHGSS) PartyPokemonSize() uint {
+	return consts.PARTY_POKEMON_SIZE
+}
+
+func (sav *savHGSS) Version() gamever.GameVer {
+	return sav.version
+}
+
+// party and trainer data live in the small block, box data in the big block
+func (sav *savHGSS) UpdateChecksums() {
+	latest := sav.LatestData()
+	latest.SmallBlock.writeChecksum(sav.data)
+	latest.BigBlock.writeChecksum(sav.data)
+}
+
 func (sav *savHGSS) Get(start uint, numBytes uint) []byte {
 	return sav.data[start : start+numBytes]
 }
@@ -0,0 +1,368 @@
+package sav
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/consts/gamever"
+	"github.com/dingdongg/pkmn-rom-parser/v7/crypt"
+)
+
+// builds a blank 512KB gen 4 savefile with consistent footers in both chunks
+func mockGen4Save(smallBlockSize, bigBlockStart, bigBlockSize, footerSize uint) []byte {
+	savefile := make([]byte, 0x80000)
+
+	for _, offset := range []uint{0x0, 0x40000} {
+		writeMockFooter(savefile, offset, smallBlockSize, footerSize, 0)
+		writeMockFooter(savefile, offset+bigBlockStart, bigBlockSize, footerSize, 1)
+	}
+
+	return savefile
+}
+
+func writeMockFooter(savefile []byte, start, blockSize, footerSize uint, blockType uint16) {
+	footer := savefile[start+blockSize-0x14 : start+blockSize]
+	binary.LittleEndian.PutUint32(footer[0x4:0x8], 1)
+	binary.LittleEndian.PutUint32(footer[0x8:0xC], uint32(blockSize))
+	binary.LittleEndian.PutUint32(footer[0xC:0x10], MAGIC_TIMESTAMP_JP_INTL)
+	binary.LittleEndian.PutUint16(footer[0x10:0x12], blockType)
+
+	checksum := crypt.CRC16_CCITT(savefile[start : start+blockSize-footerSize])
+	binary.LittleEndian.PutUint16(footer[0x12:0x14], checksum)
+}
+
+func TestIdentifyDP(t *testing.T) {
+	savefile := mockGen4Save(DP_SB_END, DP_BB_START, DP_BB_END-DP_BB_START, 0x14)
+
+	game, err := Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	dp, ok := game.(*savDP)
+	if !ok {
+		t.Fatalf("expected a DP savefile, got %T\n", game)
+	}
+
+	if dp.version != gamever.DP {
+		t.Fatalf("expected version %d, got %d\n", gamever.DP, dp.version)
+	}
+
+	if game.PartyOffset() != 0x98 {
+		t.Fatalf("expected 0x%x, got 0x%x\n", 0x98, game.PartyOffset())
+	}
+}
+
+func TestIdentifyUnknownGame(t *testing.T) {
+	if _, err := Validate(make([]byte, 0x80000)); err == nil {
+		t.Fatal("expected blank savefile to be rejected")
+	}
+}
+
+// builds a blank 512KB gen 5 savefile with a consistent party block and checksum table
+func mockGen5Save(tableOffset, tableSize uint) []byte {
+	savefile := make([]byte, 0x80000)
+	game := &gen5Savefile{
+		data:          savefile,
+		checksumTable: gen5Block{tableOffset, tableSize, 0},
+		partyBlock:    gen5Block{GEN5_PARTY_BLOCK, GEN5_PARTY_BLOCK_SIZE, GEN5_PARTY_BLOCK_INDEX},
+	}
+	game.updateChecksums()
+
+	return savefile
+}
+
+func TestIdentifyGen5(t *testing.T) {
+	cases := []struct {
+		savefile []byte
+		version  gamever.GameVer
+	}{
+		{mockGen5Save(BW_CHECKSUM_TABLE, BW_CHECKSUM_TABLE_SIZE), gamever.BW},
+		{mockGen5Save(B2W2_CHECKSUM_TABLE, B2W2_CHECKSUM_TABLE_SIZE), gamever.B2W2},
+	}
+
+	for _, c := range cases {
+		game, err := Validate(c.savefile)
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		if game.Version() != c.version {
+			t.Fatalf("expected version %d, got %d\n", c.version, game.Version())
+		}
+
+		if game.PartyPokemonSize() != 220 {
+			t.Fatalf("expected %d, got %d\n", 220, game.PartyPokemonSize())
+		}
+	}
+}
+
+func TestGen5PartyChecksum(t *testing.T) {
+	savefile := mockGen5Save(BW_CHECKSUM_TABLE, BW_CHECKSUM_TABLE_SIZE)
+	game := NewSavBW(savefile)
+
+	game.PartySection()[0] = 0xAB
+	if err := game.Validate(); err == nil {
+		t.Fatal("expected modified party block to fail validation")
+	}
+
+	game.UpdateChecksums()
+	if err := game.Validate(); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+}
+
+// Platinum savefile. Chunk 0x0 holds the latest small block, chunk 0x40000 the latest big block
+func readPlatinumMock(t *testing.T) []byte {
+	savefile, err := os.ReadFile("../rom_reader/mocks/new.sav")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	return savefile
+}
+
+func TestSelectLatestChunk(t *testing.T) {
+	game, err := Validate(readPlatinumMock(t))
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	selection := game.(IGen4Save).Selection()
+	expected := ChunkSelection{[2]bool{true, true}, [2]bool{true, true}, 0, 1}
+	if selection != expected {
+		t.Fatalf("expected %+v, got %+v\n", expected, selection)
+	}
+}
+
+func TestFallbackToBackupSmallBlock(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	savefile[0x100] ^= 0xFF
+
+	game, err := Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	gen4 := game.(IGen4Save)
+	selection := gen4.Selection()
+	expected := ChunkSelection{[2]bool{false, true}, [2]bool{true, true}, 1, 1}
+	if selection != expected {
+		t.Fatalf("expected %+v, got %+v\n", expected, selection)
+	}
+
+	if gen4.LatestData().SmallBlock.Address != SECOND_CHUNK_OFFSET {
+		t.Fatalf("expected 0x%x, got 0x%x\n", SECOND_CHUNK_OFFSET, gen4.LatestData().SmallBlock.Address)
+	}
+}
+
+func TestFallbackToBackupBigBlock(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	savefile[SECOND_CHUNK_OFFSET+PLAT_BB_START+0x100] ^= 0xFF
+
+	game, err := Validate(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	selection := game.(IGen4Save).Selection()
+	expected := ChunkSelection{[2]bool{true, true}, [2]bool{true, false}, 0, 0}
+	if selection != expected {
+		t.Fatalf("expected %+v, got %+v\n", expected, selection)
+	}
+}
+
+func TestAllSmallBlocksCorrupted(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	savefile[0x100] ^= 0xFF
+	savefile[SECOND_CHUNK_OFFSET+0x100] ^= 0xFF
+
+	if _, err := Validate(savefile); err == nil {
+		t.Fatal("expected savefile without any valid small block to be rejected")
+	}
+}
+
+func TestRevalidateAfterCorruption(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	game := NewSavPLAT(savefile)
+	if err := game.Validate(); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	savefile[0x100] ^= 0xFF
+	savefile[SECOND_CHUNK_OFFSET+0x100] ^= 0xFF
+	if err := game.Validate(); err == nil {
+		t.Fatal("expected savefile without any valid small block to be rejected")
+	}
+
+	// the selection made before the corruption is dropped
+	if valid := game.Selection().SmallBlockValid; valid != [2]bool{false, false} {
+		t.Fatalf("expected both small blocks to be invalid, got %v\n", valid)
+	}
+}
+
+func TestSelectionSurvivesEdits(t *testing.T) {
+	game, err := Validate(readPlatinumMock(t))
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game.PartySection()[0] ^= 0xFF
+	game.UpdateChecksums()
+
+	if err := game.Validate(); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if used := game.(IGen4Save).Selection().SmallBlockUsed; used != 0 {
+		t.Fatalf("expected %d, got %d\n", 0, used)
+	}
+}
+
+func TestRepairCopiesIntactBlock(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	savefile[0x100] ^= 0xFF
+
+	repaired, err := Repair(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := Validate(repaired)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	expected := ChunkSelection{[2]bool{true, true}, [2]bool{true, true}, 0, 1}
+	if selection := game.(IGen4Save).Selection(); selection != expected {
+		t.Fatalf("expected %+v, got %+v\n", expected, selection)
+	}
+
+	for i := uint(0); i < PLAT_SB_END; i++ {
+		if repaired[i] != repaired[SECOND_CHUNK_OFFSET+i] {
+			t.Fatalf("small blocks differ at offset 0x%x\n", i)
+		}
+	}
+
+	if savefile[0x100] == repaired[0x100] {
+		t.Fatal("expected the original savefile to be left untouched")
+	}
+}
+
+func TestRepairRecomputesChecksums(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	savefile[0x100] ^= 0xFF
+	savefile[SECOND_CHUNK_OFFSET+0x100] ^= 0xFF
+
+	repaired, err := Repair(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := Validate(repaired)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if used := game.(IGen4Save).Selection().SmallBlockUsed; used != 0 {
+		t.Fatalf("expected %d, got %d\n", 0, used)
+	}
+}
+
+func TestRepairRebuildsFooter(t *testing.T) {
+	savefile := readPlatinumMock(t)
+	// damage the block size in the second chunk's small block footer
+	footer := SECOND_CHUNK_OFFSET + PLAT_SB_END - 0x14
+	binary.LittleEndian.PutUint32(savefile[footer+0x8:], 0xDEAD)
+
+	repaired, err := Repair(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	game, err := Validate(repaired)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	rebuilt := game.(IGen4Save).Chunk(SECOND_CHUNK_OFFSET).SmallBlock.Footer
+	if rebuilt.BlockSize != uint32(PLAT_SB_END) {
+		t.Fatalf("expected 0x%x, got 0x%x\n", PLAT_SB_END, rebuilt.BlockSize)
+	}
+}
+
+func TestRepairGen5(t *testing.T) {
+	if _, err := Repair(mockGen5Save(BW_CHECKSUM_TABLE, BW_CHECKSUM_TABLE_SIZE)); err == nil {
+		t.Fatal("expected gen 5 repairs to be rejected")
+	}
+}
+
+func TestGetInfo(t *testing.T) {
+	savefile := mockGen4Save(DP_SB_END, DP_BB_START, DP_BB_END-DP_BB_START, 0x14)
+
+	// second chunk holds a newer save, but its big block is corrupted. The save
+	// number sits in the footer, outside of the checksummed data
+	binary.LittleEndian.PutUint32(savefile[SECOND_CHUNK_OFFSET+DP_SB_END-0x10:], 2)
+	savefile[SECOND_CHUNK_OFFSET+DP_BB_START] = 0xFF
+
+	info, err := GetInfo(savefile)
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if info.Version != gamever.DP || info.Region != REGION_JP_INTL {
+		t.Fatalf("expected DP/JP_INTL, got %d/%d\n", info.Version, info.Region)
+	}
+
+	if info.Chunks[0].SmallBlock.SaveNumber != 1 || info.Chunks[1].SmallBlock.SaveNumber != 2 {
+		t.Fatalf("unexpected save numbers: %+v\n", info.Chunks)
+	}
+
+	if !info.Chunks[1].SmallBlock.ChecksumValid || info.Chunks[1].BigBlock.ChecksumValid {
+		t.Fatalf("unexpected checksum status: %+v\n", info.Chunks[1])
+	}
+
+	if info.ActiveChunk != 1 || info.ActiveBigBlock != 0 {
+		t.Fatalf("expected active chunk 1 and big block 0, got %d and %d\n", info.ActiveChunk, info.ActiveBigBlock)
+	}
+}
+
+func TestFormFieldRoundTrip(t *testing.T) {
+	cases := []struct {
+		field FormField
+		forms []uint
+	}{
+		{FormField{0, 1, 2}, []uint{1, 0}},
+		{FormField{0, 1, 2}, []uint{0}},
+		{FormField{0, 2, 3}, []uint{2, 0}},
+		{FormField{0, 3, 6}, []uint{5, 0, 3}},
+		{FormField{0, 8, 28}, []uint{27}},
+	}
+
+	for _, c := range cases {
+		section := make([]byte, 0x20)
+		c.field.Write(section, c.forms)
+
+		forms := c.field.Read(section)
+		if len(forms) != len(c.forms) {
+			t.Fatalf("expected forms %v, got %v\n", c.forms, forms)
+		}
+
+		for i := range forms {
+			if forms[i] != c.forms[i] {
+				t.Fatalf("expected forms %v, got %v\n", c.forms, forms)
+			}
+		}
+	}
+}
+
+func TestFormFieldPreservesNeighbours(t *testing.T) {
+	// Shellos only uses the 2 lowest bits of its byte, the other ones stay set
+	section := []byte{0xFF}
+	FormField{0, 1, 2}.Write(section, []uint{0, 1})
+
+	if section[0] != 0xFE {
+		t.Fatalf("expected 0x%x, got 0x%x\n", 0xFE, section[0])
+	}
+}
@@ -0,0 +1,69 @@
+package sav
+
+import "fmt"
+
+// Reports which blocks passed their checksum validation, and which ones were picked as the
+// latest save data. Indexes refer to chunks: 0 is the chunk at 0x0, 1 is the chunk at 0x40000
+type ChunkSelection struct {
+	SmallBlockValid [2]bool
+	BigBlockValid   [2]bool
+	SmallBlockUsed  uint
+	BigBlockUsed    uint
+}
+
+/*
+Picks the small and big blocks the game would load.
+
+The small block with the highest save number wins, unless its checksum is invalid,
+in which case the game silently falls back to the backup. The big block is the one
+linked to the chosen small block via its identifier, with the same fallback rule.
+
+A selection is always returned so that callers can still inspect a corrupted
+savefile, but an error is returned if either block type has no valid copy left.
+*/
+func selectChunk(chunks [2]Chunk) (ChunkSelection, error) {
+	var selection ChunkSelection
+
+	for i, c := range chunks {
+		selection.SmallBlockValid[i] = c.SmallBlock.IsValid()
+		selection.BigBlockValid[i] = c.BigBlock.IsValid()
+	}
+
+	if chunks[0].SmallBlock.Footer.SaveNumber < chunks[1].SmallBlock.Footer.SaveNumber {
+		selection.SmallBlockUsed = 1
+	}
+	selection.SmallBlockUsed = fallback(selection.SmallBlockUsed, selection.SmallBlockValid)
+
+	latestSmallBlock := chunks[selection.SmallBlockUsed].SmallBlock
+	if latestSmallBlock.Footer.Identifier != chunks[0].BigBlock.Footer.Identifier {
+		selection.BigBlockUsed = 1
+	}
+	selection.BigBlockUsed = fallback(selection.BigBlockUsed, selection.BigBlockValid)
+
+	if !selection.SmallBlockValid[selection.SmallBlockUsed] {
+		return selection, fmt.Errorf("invalid savefile: both small blocks are corrupted")
+	}
+
+	if !selection.BigBlockValid[selection.BigBlockUsed] {
+		return selection, fmt.Errorf("invalid savefile: both big blocks are corrupted")
+	}
+
+	return selection, nil
+}
+
+// switches to the other chunk's block if the preferred one is invalid and the other one isn't
+func fallback(preferred uint, valid [2]bool) uint {
+	other := 1 - preferred
+	if !valid[preferred] && valid[other] {
+		return other
+	}
+
+	return preferred
+}
+
+func latestChunk(chunks [2]Chunk, selection ChunkSelection) *Chunk {
+	return &Chunk{
+		SmallBlock: chunks[selection.SmallBlockUsed].SmallBlock,
+		BigBlock:   chunks[selection.BigBlockUsed].BigBlock,
+	}
+}
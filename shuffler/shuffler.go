@@ -76,8 +76,7 @@ var unshuffleTable [24]blockOrder = [24]blockOrder{
 // Unless you need the offset address to the block, you want to use this function (NOT GetPokemonBlockLocation())
 func GetPokemonBlock(buf []byte, block uint, personality uint32) ([]byte, error) {
 	if block >= A && block <= D {
-		shiftValue := ((personality & 0x03E000) >> 0x0D) % 24
-		unshuffleInfo := unshuffleTable[shiftValue]
+		unshuffleInfo := unshuffleTable[shiftValue(personality)]
 		startAddr := unshuffleInfo.GetUnshuffledPos(block)
 		blockChunk := buf[startAddr : startAddr+consts.BLOCK_SIZE_BYTES]
 
@@ -90,8 +89,7 @@ func GetPokemonBlock(buf []byte, block uint, personality uint32) ([]byte, error)
 // Used to get the absolute memory address location of the block. Mainly for writing purposes ATM
 func GetPokemonBlockLocation(block uint, personality uint32) (uint, error) {
 	if block >= A && block <= D {
-		shiftValue := ((personality & 0x03E000) >> 0x0D) % 24
-		unshuffleInfo := unshuffleTable[shiftValue]
+		unshuffleInfo := unshuffleTable[shiftValue(personality)]
 		startAddr := unshuffleInfo.GetUnshuffledPos(block)
 
 		return startAddr, nil
@@ -99,6 +97,47 @@ func GetPokemonBlockLocation(block uint, personality uint32) (uint, error) {
 	return 0, errors.New("invalid block index")
 }
 
+// Returns a copy of the decrypted pokemon with its blocks in ABCD order, wherever the
+// personality value put them. Bytes outside of the blocks are copied as-is
+func Unshuffle(buf []byte, personality uint32) ([]byte, error) {
+	return reorder(buf, personality, false)
+}
+
+// Returns a copy of the decrypted pokemon with its blocks moved from ABCD order to the
+// order the personality value expects. Bytes outside of the blocks are copied as-is
+func Shuffle(buf []byte, personality uint32) ([]byte, error) {
+	return reorder(buf, personality, true)
+}
+
+func reorder(buf []byte, personality uint32, shuffle bool) ([]byte, error) {
+	blocksEnd := 0x8 + 4*consts.BLOCK_SIZE_BYTES
+	if len(buf) < blocksEnd {
+		return []byte{}, fmt.Errorf("expected at least %d bytes of pokemon data, got %d", blocksEnd, len(buf))
+	}
+
+	res := make([]byte, len(buf))
+	copy(res, buf)
+	order := unshuffleTable[shiftValue(personality)]
+
+	for block := uint(A); block <= D; block++ {
+		shuffled := order.GetUnshuffledPos(block)
+		canonical := 0x8 + block*consts.BLOCK_SIZE_BYTES
+
+		if shuffle {
+			copy(res[shuffled:shuffled+consts.BLOCK_SIZE_BYTES], buf[canonical:])
+		} else {
+			copy(res[canonical:canonical+consts.BLOCK_SIZE_BYTES], buf[shuffled:])
+		}
+	}
+
+	return res, nil
+}
+
+// index into unshuffleTable
+func shiftValue(personality uint32) uint32 {
+	return ((personality & 0x03E000) >> 0x0D) % 24
+}
+
 /*
 block is one of 0, 1, 2, 3
 
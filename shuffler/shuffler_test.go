@@ -49,3 +49,52 @@ func TestGetPokemonBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestUnshuffle(t *testing.T) {
+	blocks := []uint{A, B, C, D}
+
+	for shift := uint32(0); shift < 24; shift++ {
+		personality := shift << 0x0D
+		mockBuffer := make([]byte, 236)
+		for i := range mockBuffer {
+			mockBuffer[i] = byte(i)
+		}
+
+		res, err := Unshuffle(mockBuffer, personality)
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		for _, b := range blocks {
+			expected, _ := GetPokemonBlock(mockBuffer, b, personality)
+			start := 0x8 + b*consts.BLOCK_SIZE_BYTES
+
+			if string(res[start:start+consts.BLOCK_SIZE_BYTES]) != string(expected) {
+				t.Fatalf("shift %d: expected block %d at 0x%x\n", shift, b, start)
+			}
+		}
+
+		if string(res[:0x8]) != string(mockBuffer[:0x8]) || string(res[0x88:]) != string(mockBuffer[0x88:]) {
+			t.Fatalf("shift %d: expected bytes outside of the blocks to be kept\n", shift)
+		}
+
+		shuffled, err := Shuffle(res, personality)
+		if err != nil {
+			t.Fatal("Unexpected error ", err)
+		}
+
+		if string(shuffled) != string(mockBuffer) {
+			t.Fatalf("shift %d: expected shuffling to undo unshuffling\n", shift)
+		}
+	}
+}
+
+func TestUnshuffleTooShort(t *testing.T) {
+	if _, err := Unshuffle(make([]byte, 0x87), 0); err == nil {
+		t.Fatal("expected a buffer without all 4 blocks to be rejected")
+	}
+
+	if _, err := Shuffle(make([]byte, 0x87), 0); err == nil {
+		t.Fatal("expected a buffer without all 4 blocks to be rejected")
+	}
+}
@@ -0,0 +1,59 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/data"
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+)
+
+// Shedinja's HP is always 1, regardless of the formula
+const SHEDINJA = 292
+
+/*
+Computes the battle stats the games derive from the species' base stats:
+
+	HP    = floor((2 * base + IV + floor(EV / 4)) * level / 100) + level + 10
+	other = floor((floor((2 * base + IV + floor(EV / 4)) * level / 100) + 5) * nature / 100)
+
+where nature is 110 for the boosted stat, 90 for the hindered one and 100 otherwise
+*/
+func Calculate(dexId uint16, ivs, evs rom_reader.Stats, level uint, nature string) (rom_reader.Stats, error) {
+	if level < 1 || level > 100 {
+		return rom_reader.Stats{}, fmt.Errorf("level must be between 1 and 100")
+	}
+
+	species, err := data.GetSpecies(dexId)
+	if err != nil {
+		return rom_reader.Stats{}, fmt.Errorf("no base stats for pokedex ID %d", dexId)
+	}
+
+	// attack, defense, speed, special attack, special defense
+	modifiers, ok := data.GenerateNatureMap()[nature]
+	if !ok {
+		return rom_reader.Stats{}, fmt.Errorf("invalid nature '%s'", nature)
+	}
+
+	base := species.BaseStats
+	other := func(b, iv, ev, modifier uint) uint {
+		return (core(b, iv, ev, level) + 5) * modifier / 100
+	}
+
+	hp := core(base.Hp, ivs.Hp, evs.Hp, level) + level + 10
+	if dexId == SHEDINJA {
+		hp = 1
+	}
+
+	return rom_reader.Stats{
+		Hp:        hp,
+		Attack:    other(base.Attack, ivs.Attack, evs.Attack, modifiers[0]),
+		Defense:   other(base.Defense, ivs.Defense, evs.Defense, modifiers[1]),
+		SpAttack:  other(base.SpAttack, ivs.SpAttack, evs.SpAttack, modifiers[3]),
+		SpDefense: other(base.SpDefense, ivs.SpDefense, evs.SpDefense, modifiers[4]),
+		Speed:     other(base.Speed, ivs.Speed, evs.Speed, modifiers[2]),
+	}, nil
+}
+
+func core(base, iv, ev, level uint) uint {
+	return (2*base + iv + ev/4) * level / 100
+}
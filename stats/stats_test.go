@@ -0,0 +1,50 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dingdongg/pkmn-rom-parser/v7/rom_reader"
+)
+
+// the worked example from https://bulbapedia.bulbagarden.net/wiki/Stat
+func TestCalculate(t *testing.T) {
+	ivs := rom_reader.Stats{Hp: 24, Attack: 12, Defense: 30, SpAttack: 16, SpDefense: 23, Speed: 5}
+	evs := rom_reader.Stats{Hp: 74, Attack: 190, Defense: 91, SpAttack: 48, SpDefense: 84, Speed: 23}
+
+	result, err := Calculate(445, ivs, evs, 78, "Adamant")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	expected := rom_reader.Stats{Hp: 289, Attack: 278, Defense: 193, SpAttack: 135, SpDefense: 171, Speed: 171}
+	if !cmp.Equal(result, expected) {
+		t.Fatalf("expected %+v, but got %+v\n", expected, result)
+	}
+}
+
+func TestCalculateShedinja(t *testing.T) {
+	result, err := Calculate(SHEDINJA, rom_reader.Stats{Hp: 31, Attack: 31, Defense: 31, SpAttack: 31, SpDefense: 31, Speed: 31}, rom_reader.Stats{}, 100, "Hardy")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+
+	if result.Hp != 1 {
+		t.Fatalf("expected %d, but got %d\n", 1, result.Hp)
+	}
+}
+
+func TestCalculateValidation(t *testing.T) {
+	if _, err := Calculate(445, rom_reader.Stats{}, rom_reader.Stats{}, 0, "Adamant"); err == nil {
+		t.Fatal("expected level 0 to be rejected")
+	}
+
+	if _, err := Calculate(494, rom_reader.Stats{}, rom_reader.Stats{}, 50, "Adamant"); err == nil {
+		t.Fatal("expected an unknown species to be rejected")
+	}
+
+	if _, err := Calculate(445, rom_reader.Stats{}, rom_reader.Stats{}, 50, "Grumpy"); err == nil {
+		t.Fatal("expected an unknown nature to be rejected")
+	}
+}